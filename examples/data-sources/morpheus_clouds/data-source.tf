@@ -4,4 +4,12 @@ data "morpheus_clouds" "tf_example_clouds" {
     name   = "name"
     values = ["Test*"]
   }
+  filter {
+    name   = "labels"
+    values = ["^prod$"]
+  }
+  filter {
+    name   = "tag:owner"
+    values = ["platform"]
+  }
 }
\ No newline at end of file
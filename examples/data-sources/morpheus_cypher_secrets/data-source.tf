@@ -0,0 +1,4 @@
+data "morpheus_cypher_secrets" "app1" {
+  prefix    = "secret/app1"
+  recursive = true
+}
@@ -0,0 +1,10 @@
+data "morpheus_instance" "tf_example_instance" {
+  name = "tf-example-instance"
+}
+
+data "morpheus_instance" "tf_example_instance_labels" {
+  labels = ["web"]
+  tags = {
+    environment = "production"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+data "morpheus_instances" "tf_example_instances" {
+  sort_ascending = true
+  filter {
+    name   = "labels"
+    values = ["^web$"]
+  }
+  filter {
+    name   = "status"
+    values = ["^running$"]
+  }
+  filter {
+    name   = "tag:environment"
+    values = ["production"]
+  }
+}
\ No newline at end of file
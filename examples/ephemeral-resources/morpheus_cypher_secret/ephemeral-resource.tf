@@ -0,0 +1,11 @@
+ephemeral "morpheus_cypher_secret" "database_password" {
+  key = "app1/database/password"
+}
+
+resource "morpheus_credential" "database" {
+  name                = "app1-database"
+  type                = "username-password"
+  username            = "app1"
+  password_wo         = ephemeral.morpheus_cypher_secret.database_password.value
+  password_wo_version = 1
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+data "morpheus_blueprint" "web_app" {
+  name = "Web App"
+}
+
+data "morpheus_group" "morpheus_lab" {
+  name = "MORPHEUS"
+}
+
+data "morpheus_cloud" "morpheus_vsphere" {
+  name = "MORPHEUSVCENTER"
+}
+
+resource "morpheus_app" "tf_example_app" {
+  name             = "tfwebapp"
+  description      = "Terraform app example"
+  blueprint_id     = data.morpheus_blueprint.web_app.id
+  group_id         = data.morpheus_group.morpheus_lab.id
+  environment      = "dev"
+  default_cloud_id = data.morpheus_cloud.morpheus_vsphere.id
+  labels           = ["demo", "terraform"]
+
+  tier {
+    name = "Web"
+    config = jsonencode({
+      plan = {
+        id = 10
+      }
+    })
+  }
+
+  tier {
+    name = "Database"
+  }
+}
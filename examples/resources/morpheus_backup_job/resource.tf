@@ -0,0 +1,7 @@
+resource "morpheus_backup_job" "tf_example_backup_job" {
+  name                = "tf-example-nightly-backups"
+  code                = "tf-example-nightly-backups"
+  execute_schedule_id = morpheus_execute_schedule.tf_example_execute_schedule.id
+  retention_count     = 7
+  storage_provider_id = morpheus_storage_bucket.tf_example_storage_bucket.id
+}
@@ -0,0 +1 @@
+terraform import morpheus_cypher_key.tf_example_cypher_key 256/app1/encryptionkey
@@ -0,0 +1,4 @@
+resource "morpheus_cypher_key" "tf_example_cypher_key" {
+  key  = "app1/encryptionkey"
+  bits = 256
+}
@@ -0,0 +1 @@
+terraform import morpheus_cypher_password.tf_example_cypher_password 20/app1/dbpassword
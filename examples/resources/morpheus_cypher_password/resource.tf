@@ -0,0 +1,9 @@
+resource "morpheus_cypher_password" "tf_example_cypher_password" {
+  key          = "app1/dbpassword"
+  length       = 20
+  ttl          = 86400
+  renew_before = 3600
+  rotation_trigger = {
+    rotated = "2024-06-01"
+  }
+}
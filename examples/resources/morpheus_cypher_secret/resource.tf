@@ -2,4 +2,21 @@ resource "morpheus_cypher_secret" "tf_example_cypher_secret" {
   key   = "apipassword"
   value = "password123"
   ttl   = 86400
-}
\ No newline at end of file
+}
+
+resource "morpheus_cypher_secret" "tf_example_cypher_secret_map" {
+  key = "app1/database"
+  value_map = {
+    username = "app1"
+    password = "password123"
+  }
+}
+
+resource "morpheus_cypher_secret" "tf_example_cypher_secret_json" {
+  mount = "vault"
+  key   = "app1/settings"
+  value_json = jsonencode({
+    endpoints = ["https://app1.example.com"]
+    retries   = 3
+  })
+}
@@ -0,0 +1 @@
+terraform import morpheus_cypher_uuid.tf_example_cypher_uuid app1/clientid
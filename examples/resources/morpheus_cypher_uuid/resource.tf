@@ -0,0 +1,3 @@
+resource "morpheus_cypher_uuid" "tf_example_cypher_uuid" {
+  key = "app1/clientid"
+}
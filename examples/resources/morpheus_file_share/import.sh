@@ -0,0 +1 @@
+terraform import morpheus_file_share.tf_example_file_share 1
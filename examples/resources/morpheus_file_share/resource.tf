@@ -0,0 +1,19 @@
+resource "morpheus_file_share" "tf_example_file_share" {
+  name                      = "tf-example-cifs-share"
+  default_deployment_target = true
+
+  cifs {
+    host       = "fileserver.example.com"
+    share_path = "/deployments"
+    username   = "morpheus"
+    password   = "my-cifs-password"
+  }
+}
+
+resource "morpheus_file_share" "tf_example_file_share_local" {
+  name = "tf-example-local-share"
+
+  local {
+    base_path = "/var/opt/morpheus/shares"
+  }
+}
@@ -0,0 +1,63 @@
+data "morpheus_group" "morpheus_lab" {
+  name = "MORPHEUS"
+}
+
+data "morpheus_cloud" "azure" {
+  name = "MORPHEUSAZURE"
+}
+
+data "morpheus_instance_type" "ubuntu" {
+  name = "Ubuntu"
+}
+
+data "morpheus_instance_layout" "ubuntu" {
+  name    = "Azure VM"
+  version = "22.04"
+}
+
+data "morpheus_network" "subnet" {
+  name = "default"
+}
+
+data "morpheus_plan" "azure" {
+  name           = "Standard_B2s"
+  provision_type = "azure"
+}
+
+resource "morpheus_instance" "tf_example_instance" {
+  name               = "tfazure"
+  description        = "Terraform instance example"
+  cloud_id           = data.morpheus_cloud.azure.id
+  group_id           = data.morpheus_group.morpheus_lab.id
+  instance_type_code = data.morpheus_instance_type.ubuntu.code
+  instance_layout_id = data.morpheus_instance_layout.ubuntu.id
+  plan_id            = data.morpheus_plan.azure.id
+  resource_pool_id   = 1
+  environment        = "dev"
+  labels             = ["demo", "terraform"]
+
+  config = {
+    availabilityZone = "1"
+    securityGroups   = jsonencode([{ id = "nsg-demo" }])
+  }
+
+  interfaces {
+    network_id = data.morpheus_network.subnet.id
+  }
+
+  volumes {
+    root = true
+    name = "root"
+    size = 30
+  }
+
+  volumes {
+    root = false
+    name = "data"
+    size = 50
+  }
+
+  tags = {
+    name = "tfazure"
+  }
+}
@@ -0,0 +1,11 @@
+resource "morpheus_instance_backup" "tf_example_instance_backup" {
+  instance_id      = morpheus_instance.tf_example_instance.id
+  name             = "tf-example-instance-backup"
+  job_id           = morpheus_backup_job.tf_example_backup_job.id
+  backup_type_code = "vmwareSnapshot"
+  retention_count  = 14
+  run_on_create    = true
+  config = jsonencode({
+    copyToStore = true
+  })
+}
@@ -0,0 +1,13 @@
+resource "morpheus_load_balancer" "tf_example_load_balancer" {
+  name        = "tf-example-f5"
+  type_code   = "f5"
+  description = "Terraform example F5 BIG-IP load balancer"
+  host        = "f5.example.com"
+  port        = 443
+  username    = "admin"
+  password    = "Password123?"
+  visibility  = "private"
+  config = jsonencode({
+    partition = "Common"
+  })
+}
@@ -0,0 +1 @@
+terraform import morpheus_load_balancer_monitor.tf_example_load_balancer_monitor 1:2
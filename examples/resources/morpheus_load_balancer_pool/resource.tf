@@ -0,0 +1,18 @@
+resource "morpheus_load_balancer_pool" "tf_example_load_balancer_pool" {
+  load_balancer_id = morpheus_load_balancer.tf_example_load_balancer.id
+  name             = "tf-example-web-pool"
+  description      = "Terraform example web pool"
+  balance_mode     = "leastconnections"
+  monitor_ids      = [morpheus_load_balancer_monitor.tf_example_load_balancer_monitor.id]
+
+  member {
+    instance_id = morpheus_instance.tf_example_web_1.id
+    port        = 8080
+  }
+
+  member {
+    ip_address = "10.100.0.21"
+    port       = 8080
+    weight     = 2
+  }
+}
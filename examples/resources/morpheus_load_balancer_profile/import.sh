@@ -0,0 +1 @@
+terraform import morpheus_load_balancer_profile.tf_example_load_balancer_profile 1:2
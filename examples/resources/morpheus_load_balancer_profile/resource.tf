@@ -0,0 +1,6 @@
+resource "morpheus_load_balancer_profile" "tf_example_load_balancer_profile" {
+  load_balancer_id = morpheus_load_balancer.tf_example_load_balancer.id
+  name             = "tf-example-http-profile"
+  description      = "Terraform example HTTP profile"
+  service_type     = "http"
+}
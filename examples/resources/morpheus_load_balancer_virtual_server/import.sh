@@ -0,0 +1 @@
+terraform import morpheus_load_balancer_virtual_server.tf_example_load_balancer_virtual_server 1:2
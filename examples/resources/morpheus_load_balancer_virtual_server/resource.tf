@@ -0,0 +1,11 @@
+resource "morpheus_load_balancer_virtual_server" "tf_example_load_balancer_virtual_server" {
+  load_balancer_id = morpheus_load_balancer.tf_example_load_balancer.id
+  name             = "tf-example-web-vip"
+  description      = "Terraform example web virtual server"
+  vip_address      = "10.100.0.100"
+  vip_port         = 443
+  vip_protocol     = "https"
+  vip_hostname     = "web.example.com"
+  pool_id          = morpheus_load_balancer_pool.tf_example_load_balancer_pool.id
+  profile_ids      = [morpheus_load_balancer_profile.tf_example_load_balancer_profile.id]
+}
@@ -0,0 +1 @@
+terraform import morpheus_network.tf_example_network 1
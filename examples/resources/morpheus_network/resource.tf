@@ -0,0 +1,32 @@
+data "morpheus_cloud" "vsphere" {
+  name = "vSphere"
+}
+
+resource "morpheus_ipv4_ip_pool" "tf_example_pool" {
+  name = "tf-example-pool"
+  ip_range {
+    starting_address = "10.100.0.50"
+    ending_address   = "10.100.0.200"
+  }
+}
+
+resource "morpheus_network" "tf_example_network" {
+  name                  = "tf-example-network"
+  display_name          = "TF Example Network"
+  description           = "Terraform example network"
+  labels                = ["demo", "terraform"]
+  cloud_id              = data.morpheus_cloud.vsphere.id
+  type_id               = 1
+  cidr                  = "10.100.0.0/24"
+  gateway               = "10.100.0.1"
+  dns_primary           = "10.100.0.10"
+  dns_secondary         = "10.100.0.11"
+  search_domains        = "example.com"
+  vlan_id               = 100
+  dhcp_server           = false
+  allow_static_override = true
+  pool_id               = morpheus_ipv4_ip_pool.tf_example_pool.id
+  visibility            = "public"
+  all_group_access      = true
+  tenant_ids            = [1]
+}
@@ -0,0 +1 @@
+terraform import morpheus_network_group.tf_example_network_group 1
@@ -0,0 +1,15 @@
+data "morpheus_network" "tf_example_network_a" {
+  name = "tf-example-network-a"
+}
+
+data "morpheus_network" "tf_example_network_b" {
+  name = "tf-example-network-b"
+}
+
+resource "morpheus_network_group" "tf_example_network_group" {
+  name             = "tf-example-network-group"
+  description      = "Terraform example network group"
+  network_ids      = [data.morpheus_network.tf_example_network_a.id, data.morpheus_network.tf_example_network_b.id]
+  visibility       = "private"
+  all_group_access = true
+}
@@ -0,0 +1 @@
+terraform import morpheus_network_subnet.tf_example_network_subnet 1
@@ -0,0 +1,20 @@
+data "morpheus_network" "tf_example_network" {
+  name = "tf-example-network"
+}
+
+resource "morpheus_network_subnet" "tf_example_network_subnet" {
+  network_id            = data.morpheus_network.tf_example_network.id
+  name                  = "tf-example-subnet"
+  description           = "Terraform example network subnet"
+  cidr                  = "10.100.1.0/24"
+  gateway               = "10.100.1.1"
+  dns_primary           = "10.100.0.10"
+  dns_secondary         = "10.100.0.11"
+  dhcp_server           = true
+  allow_static_override = false
+  visibility            = "private"
+  group_access {
+    group_id = 1
+    default  = true
+  }
+}
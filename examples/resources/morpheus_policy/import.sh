@@ -0,0 +1 @@
+terraform import morpheus_policy.tf_example_policy 1
@@ -0,0 +1,20 @@
+resource "morpheus_policy" "tf_example_policy_global" {
+  name             = "tf-example-max-pool-members"
+  description      = "Terraform example max pool members policy"
+  policy_type_code = "maxPoolMembers"
+  scope            = "global"
+  config = {
+    maxPoolMembers = "10"
+  }
+}
+
+resource "morpheus_policy" "tf_example_policy_group" {
+  name             = "tf-example-approve-reconfigure"
+  description      = "Terraform example approve reconfigure policy"
+  policy_type_code = "approveReconfigure"
+  scope            = "group"
+  group_id         = 1
+  config = {
+    accountIntegrationId = "2"
+  }
+}
@@ -0,0 +1 @@
+terraform import morpheus_security_group.tf_example_security_group 1
@@ -0,0 +1,12 @@
+data "morpheus_cloud" "aws" {
+  name = "AWS"
+}
+
+resource "morpheus_security_group" "tf_example_security_group" {
+  name        = "tf-example-security-group"
+  description = "Terraform example security group"
+  cloud_id    = data.morpheus_cloud.aws.id
+  custom_options = {
+    vpc = "vpc-0123456789abcdef0"
+  }
+}
@@ -0,0 +1 @@
+terraform import morpheus_security_group_rule.tf_example_security_group_rule 1:2
@@ -0,0 +1,26 @@
+resource "morpheus_security_group" "tf_example_security_group" {
+  name        = "tf-example-security-group"
+  description = "Terraform example security group"
+}
+
+resource "morpheus_security_group_rule" "tf_example_security_group_rule" {
+  security_group_id = morpheus_security_group.tf_example_security_group.id
+  name              = "Allow SSH"
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range        = "22"
+  source_type       = "cidr"
+  source            = "10.0.0.0/8"
+  destination_type  = "instance"
+}
+
+resource "morpheus_security_group_rule" "tf_example_security_group_rule_web" {
+  security_group_id       = morpheus_security_group.tf_example_security_group.id
+  name                    = "Allow web tier"
+  direction               = "ingress"
+  protocol                = "tcp"
+  port_range              = "8080-8090"
+  source_type             = "tier"
+  source_instance_type_id = 5
+  destination_type        = "instance"
+}
@@ -0,0 +1 @@
+terraform import morpheus_storage_bucket.tf_example_storage_bucket 1
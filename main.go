@@ -5,15 +5,53 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
 	"github.com/gomorpheus/terraform-provider-morpheus/morpheus"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/gomorpheus/terraform-provider-morpheus/version"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
+// providerAddr is the registry address of the provider, used to serve it
+// in debug mode.
+const providerAddr = "registry.terraform.io/gomorpheus/morpheus"
+
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return morpheus.Provider()
-		},
-	})
+	var debug, printVersion bool
+
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&printVersion, "version", false, "print the version of the provider and exit")
+	flag.Parse()
+
+	if printVersion {
+		fmt.Printf("terraform-provider-morpheus %s\n", version.ProviderVersion)
+		return
+	}
+
+	// The SDK provider is muxed with a plugin framework provider serving the
+	// ephemeral resources, which reads the API client from the SDK provider
+	ctx := context.Background()
+	sdkProvider := morpheus.Provider()
+	providers := []func() tfprotov5.ProviderServer{
+		providerserver.NewProtocol5(morpheus.NewFrameworkProvider(sdkProvider)),
+		sdkProvider.GRPCProvider,
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+	if err := tf5server.Serve(providerAddr, muxServer.ProviderServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -1,12 +1,24 @@
 package morpheus
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"golang.org/x/time/rate"
 )
 
 // Config is the configuration structure used to instantiate the Morpheus
@@ -14,39 +26,428 @@ import (
 type Config struct {
 	Url             string
 	AccessToken     string
-	RefreshToken    string // optional and unused
+	RefreshToken    string
 	Username        string
 	Password        string
 	ClientId        string
 	TenantSubdomain string
-	// Scope            string // "scope"
-	// GrantType            string  // "bearer"
+	// AccessTokenFile is the path to a file holding the access token.
+	// It is read again whenever the token is refreshed, so a token
+	// rotated by an external process is picked up mid-run.
+	AccessTokenFile string
 
-	Insecure bool
+	// TLS settings used by the HTTP transport of the client.
+	// The CA and client certificates may be given either as a
+	// path to a PEM file or as the PEM content itself.
+	Insecure       bool
+	CACertFile     string
+	CACertPEM      string
+	ClientCertFile string
+	ClientCertPEM  string
+	ClientKeyFile  string
+	ClientKeyPEM   string
+
+	// Provider level overrides for the wait loops of resources
+	// that poll for a status. Zero values keep the defaults of
+	// each resource.
+	PollInterval time.Duration
+	PollDelay    time.Duration
+
+	// Retry and throttling settings shared by every request made
+	// by the client. A RequestsPerSecond of zero disables throttling.
+	MaxRetries        int
+	RetryWaitMin      time.Duration
+	RetryWaitMax      time.Duration
+	RequestsPerSecond float64
+
+	// ForceDelete forces the deletion of every instance and cluster that
+	// does not set the force_delete argument of the resource.
+	ForceDelete bool
 
 	client *morpheus.Client
 }
 
+// providerMeta is handed to resources and data sources as meta. It holds
+// the client along with the Config it was created from, so resources can
+// honor provider level settings.
+type providerMeta struct {
+	client *morpheus.Client
+	config *Config
+}
+
+// providerConfig returns the Config the meta was created from, or an empty
+// Config if it is not known.
+func providerConfig(meta interface{}) *Config {
+	if m, ok := meta.(*providerMeta); ok && m.config != nil {
+		return m.config
+	}
+	return &Config{}
+}
+
+// meta returns the meta handed to resources and data sources, creating the
+// client if needed.
+func (c *Config) meta() (*providerMeta, diag.Diagnostics) {
+	client, diags := c.Client()
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &providerMeta{client: client, config: c}, diags
+}
+
 func (c *Config) Client() (*morpheus.Client, diag.Diagnostics) {
 
 	debug := logging.IsDebugOrHigher() && os.Getenv("MORPHEUS_API_HTTPTRACE") == "true"
 
 	if c.client == nil {
-		client := morpheus.NewClient(c.Url, morpheus.WithDebug(debug))
-		// should validate url here too, and maybe ping it
-		// logging with access token or username and password?
-		if c.Username != "" {
-			if c.TenantSubdomain != "" {
-				username := fmt.Sprintf(`%s\\%s`, c.TenantSubdomain, c.Username)
-				client.SetUsernameAndPassword(username, c.Password)
-			} else {
-				client.SetUsernameAndPassword(c.Username, c.Password)
-			}
-		} else {
-			var expiresIn int64 = 86400 // lie (unused atm)
-			client.SetAccessToken(c.AccessToken, c.RefreshToken, expiresIn, "write")
+		transport, err := c.transport()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		// The token transport authenticates every request, so the
+		// client only needs the initial token to consider itself
+		// logged in.
+		tokens := &tokenTransport{config: c, next: transport}
+		if err := tokens.login(); err != nil {
+			return nil, diag.FromErr(err)
 		}
+		client := morpheus.NewClient(c.Url, morpheus.WithDebug(debug), morpheus.WithTransport(tokens))
+		// The client logs in again once the token it was given expires,
+		// bypassing the refreshes of the token transport. The client is
+		// given a token that never expires so it leaves them to the
+		// transport.
+		client.SetAccessToken(tokens.accessToken, tokens.refreshToken, clientTokenLifetime, "write")
 		c.client = client
 	}
 	return c.client, nil
 }
+
+// transport returns the HTTP transport used for every request made by the
+// client. It is configured with the TLS settings of the provider, retries
+// throttled and failed requests and limits the rate at which requests are
+// sent to the appliance.
+func (c *Config) transport() (http.RoundTripper, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.TLSClientConfig = tlsConfig
+
+	var transport http.RoundTripper = httpTransport
+	if c.RequestsPerSecond > 0 {
+		transport = &rateLimitedTransport{
+			limiter: rate.NewLimiter(rate.Limit(c.RequestsPerSecond), 1),
+			next:    transport,
+		}
+	}
+
+	// Requests that create objects are only retried when the appliance
+	// rejected them outright, since retrying a request that may have been
+	// applied could create duplicates.
+	return &retryTransport{
+		idempotent:    &retryablehttp.RoundTripper{Client: c.retryClient(transport, retryPolicy(true))},
+		nonIdempotent: &retryablehttp.RoundTripper{Client: c.retryClient(transport, retryPolicy(false))},
+	}, nil
+}
+
+// retryClient returns a client retrying requests sent through transport
+// according to checkRetry and the retry settings of the provider.
+func (c *Config) retryClient(transport http.RoundTripper, checkRetry retryablehttp.CheckRetry) *retryablehttp.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.HTTPClient = &http.Client{Transport: transport}
+	retryClient.Logger = log.Default()
+	retryClient.RetryMax = c.MaxRetries
+	retryClient.CheckRetry = checkRetry
+	if c.RetryWaitMin > 0 {
+		retryClient.RetryWaitMin = c.RetryWaitMin
+	}
+	if c.RetryWaitMax > 0 {
+		retryClient.RetryWaitMax = c.RetryWaitMax
+	}
+	// Hand the last response back to the SDK once retries are
+	// exhausted so the API error message is not lost.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	return retryClient
+}
+
+// retryTransport sends idempotent requests and other requests through
+// transports with different retry policies.
+type retryTransport struct {
+	idempotent    http.RoundTripper
+	nonIdempotent http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return t.idempotent.RoundTrip(req)
+	}
+	return t.nonIdempotent.RoundTrip(req)
+}
+
+// retryPolicy returns the policy deciding whether a request is retried.
+// Requests rejected because the appliance is throttling or temporarily
+// unavailable (429 and 503) are always retried. Idempotent requests are
+// also retried when they failed to reach the appliance or a gateway in
+// front of it (502 and 504). Other errors, including 500, are returned
+// straight away since the request may have been partially applied.
+func retryPolicy(idempotent bool) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if err != nil {
+			if !idempotent {
+				return false, nil
+			}
+			return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		}
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return true, nil
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return idempotent, nil
+		}
+		return false, nil
+	}
+}
+
+// rateLimitedTransport delays requests so that no more than the configured
+// number of requests per second are sent.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// tokenRefreshWindow is how long before its expiry an access token is
+// refreshed.
+const tokenRefreshWindow = 5 * time.Minute
+
+// clientTokenLifetime is the lifetime in seconds of the access token given
+// to the client, ten years, as the token transport refreshes the token.
+const clientTokenLifetime = 10 * 365 * 24 * 60 * 60
+
+// tokenTransport authenticates every request made by the client with the
+// current access token. The token is refreshed before it expires and once
+// more when Morpheus rejects it, using the refresh token, the username and
+// password or by reading the access token file again.
+type tokenTransport struct {
+	config *Config
+	next   http.RoundTripper
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+}
+
+// login sets the initial access token of the transport.
+func (t *tokenTransport) login() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.accessToken = t.config.AccessToken
+	t.refreshToken = t.config.RefreshToken
+	if t.config.AccessTokenFile != "" || t.config.Username != "" {
+		return t.refresh()
+	}
+	return nil
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/oauth/token" {
+		return t.next.RoundTrip(req)
+	}
+	token, err := t.token(false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(withAccessToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !t.canRefresh() {
+		return resp, err
+	}
+	// The body of the first attempt has been consumed, so the request
+	// is only sent again when it can be rewound.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, err
+	}
+	log.Printf("[DEBUG] Access token was rejected, refreshing it")
+	if token, err = t.token(true); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	retry := withAccessToken(req, token)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	resp.Body.Close()
+	return t.next.RoundTrip(retry)
+}
+
+// token returns the current access token, refreshing it when it is about
+// to expire or when force is set.
+func (t *tokenTransport) token(force bool) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	expiring := !t.expiresAt.IsZero() && time.Until(t.expiresAt) < tokenRefreshWindow
+	if (force || expiring) && t.canRefreshLocked() {
+		if err := t.refresh(); err != nil {
+			return "", err
+		}
+	}
+	return t.accessToken, nil
+}
+
+func (t *tokenTransport) canRefresh() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.canRefreshLocked()
+}
+
+// canRefreshLocked must be called with t.mu held.
+func (t *tokenTransport) canRefreshLocked() bool {
+	return t.config.AccessTokenFile != "" || t.refreshToken != "" || t.config.Username != ""
+}
+
+// refresh must be called with t.mu held.
+func (t *tokenTransport) refresh() error {
+	if t.config.AccessTokenFile != "" {
+		data, err := os.ReadFile(t.config.AccessTokenFile)
+		if err != nil {
+			return fmt.Errorf("error reading access token file: %s", err)
+		}
+		t.accessToken = strings.TrimSpace(string(data))
+		t.expiresAt = time.Time{}
+		return nil
+	}
+	if t.refreshToken != "" {
+		err := t.requestToken("refresh_token", url.Values{"refresh_token": {t.refreshToken}})
+		if err == nil || t.config.Username == "" {
+			return err
+		}
+		log.Printf("[WARN] Error refreshing access token, logging in again: %s", err)
+	}
+	username := t.config.Username
+	if t.config.TenantSubdomain != "" {
+		username = fmt.Sprintf(`%s\\%s`, t.config.TenantSubdomain, t.config.Username)
+	}
+	return t.requestToken("password", url.Values{"username": {username}, "password": {t.config.Password}})
+}
+
+// requestToken requests a new access token from the OAuth token endpoint
+// of Morpheus with the given grant type.
+func (t *tokenTransport) requestToken(grantType string, form url.Values) error {
+	clientID := t.config.ClientId
+	if clientID == "" {
+		clientID = "morph-api"
+	}
+	query := url.Values{
+		"grant_type": {grantType},
+		"scope":      {"write"},
+		"client_id":  {clientID},
+	}
+	tokenURL := fmt.Sprintf("%s/oauth/token?%s", strings.TrimSuffix(t.config.Url, "/"), query.Encode())
+	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return fmt.Errorf("error requesting access token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+		Error        string `json:"error"`
+		Description  string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode == http.StatusOK {
+		return fmt.Errorf("error parsing access token response: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK || result.AccessToken == "" {
+		return fmt.Errorf("error requesting access token: %d %s %s", resp.StatusCode, result.Error, result.Description)
+	}
+	t.accessToken = result.AccessToken
+	if result.RefreshToken != "" {
+		t.refreshToken = result.RefreshToken
+	}
+	t.expiresAt = time.Time{}
+	if result.ExpiresIn > 0 {
+		t.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return nil
+}
+
+// withAccessToken returns a copy of req authenticated with token.
+func withAccessToken(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	caCert, err := readPEM(c.CACertFile, c.CACertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA certificate: %s", err)
+	}
+	if caCert != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("error parsing CA certificate: no PEM encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, err := readPEM(c.ClientCertFile, c.ClientCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client certificate: %s", err)
+	}
+	clientKey, err := readPEM(c.ClientKeyFile, c.ClientKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("error reading client key: %s", err)
+	}
+	if clientCert != nil || clientKey != nil {
+		if clientCert == nil || clientKey == nil {
+			return nil, fmt.Errorf("both a client certificate and a client key must be configured")
+		}
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns the PEM content found in file, or the inline content
+// if no file is set. It returns nil when neither is configured.
+func readPEM(file string, content string) ([]byte, error) {
+	if file != "" {
+		return os.ReadFile(file)
+	}
+	if content != "" {
+		return []byte(content), nil
+	}
+	return nil, nil
+}
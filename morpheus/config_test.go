@@ -0,0 +1,411 @@
+package morpheus
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+func TestConfigClient_fakeServer(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+
+	config := Config{
+		Url:         srv.URL,
+		AccessToken: "acctest",
+	}
+	client, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	resp, err := client.CreateEnvironment(&morpheus.Request{
+		Body: map[string]interface{}{
+			"environment": map[string]interface{}{
+				"name": "tfacc",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating environment: %s", err)
+	}
+	environment := resp.Result.(*morpheus.CreateEnvironmentResult).Environment
+	if environment.Name != "tfacc" {
+		t.Fatalf("expected environment name tfacc, got %q", environment.Name)
+	}
+
+	resp, err = client.FindEnvironmentByName("tfacc")
+	if err != nil {
+		t.Fatalf("error finding environment: %s", err)
+	}
+	if id := resp.Result.(*morpheus.GetEnvironmentResult).Environment.ID; id != environment.ID {
+		t.Fatalf("expected environment %d, got %d", environment.ID, id)
+	}
+
+	if _, err := client.DeleteEnvironment(environment.ID, &morpheus.Request{}); err != nil {
+		t.Fatalf("error deleting environment: %s", err)
+	}
+	resp, err = client.GetEnvironment(environment.ID, &morpheus.Request{})
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+
+	if n := len(srv.requests(http.MethodPost, "/api/environments")); n != 1 {
+		t.Fatalf("expected 1 create request to be recorded, got %d", n)
+	}
+}
+
+func TestConfigClient_cassetteReplay(t *testing.T) {
+	recorder := newFakeMorpheusServer(t)
+	client, _ := (&Config{Url: recorder.URL, AccessToken: "acctest"}).Client()
+	if _, err := client.CreateContact(&morpheus.Request{
+		Body: map[string]interface{}{
+			"contact": map[string]interface{}{
+				"name": "tfacc",
+			},
+		},
+	}); err != nil {
+		t.Fatalf("error creating contact: %s", err)
+	}
+
+	cassette := t.TempDir() + "/contact.json"
+	if err := recorder.saveCassette(cassette); err != nil {
+		t.Fatalf("error saving cassette: %s", err)
+	}
+
+	replay := newFakeMorpheusServerFromCassette(t, cassette)
+	client, _ = (&Config{Url: replay.URL, AccessToken: "acctest"}).Client()
+	resp, err := client.CreateContact(&morpheus.Request{
+		Body: map[string]interface{}{
+			"contact": map[string]interface{}{
+				"name": "tfacc",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error replaying contact create: %s", err)
+	}
+	if name := resp.Result.(*morpheus.CreateContactResult).Contact.Name; name != "tfacc" {
+		t.Fatalf("expected replayed contact name tfacc, got %q", name)
+	}
+}
+
+func TestConfigTransport_tls(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	cases := map[string]struct {
+		config  Config
+		wantErr bool
+	}{
+		"untrusted": {
+			config:  Config{},
+			wantErr: true,
+		},
+		"insecure": {
+			config: Config{Insecure: true},
+		},
+		"custom ca": {
+			config: Config{CACertPEM: caPEM},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			transport, err := tc.config.transport()
+			if err != nil {
+				t.Fatalf("unexpected error building transport: %s", err)
+			}
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected certificate verification to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestConfigTLSConfig_invalid(t *testing.T) {
+	cases := map[string]Config{
+		"bad ca pem":          {CACertPEM: "not a certificate"},
+		"missing ca file":     {CACertFile: "testdata/does-not-exist.pem"},
+		"cert without key":    {ClientCertPEM: "not a certificate"},
+		"bad client key pair": {ClientCertPEM: "not a certificate", ClientKeyPEM: "not a key"},
+	}
+
+	for name, config := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := config.tlsConfig(); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestPollSettings(t *testing.T) {
+	config := Config{
+		Url:          "https://morpheus.example.com",
+		AccessToken:  "acctest",
+		PollInterval: 5 * time.Second,
+	}
+	meta, diags := config.meta()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	if got := pollInterval(meta, time.Minute); got != 5*time.Second {
+		t.Fatalf("expected the provider poll interval, got %s", got)
+	}
+	if got := pollDelay(meta, time.Minute); got != time.Minute {
+		t.Fatalf("expected the resource poll delay, got %s", got)
+	}
+	if got := pollInterval(nil, time.Minute); got != time.Minute {
+		t.Fatalf("expected the resource poll interval for an unknown client, got %s", got)
+	}
+}
+
+func TestConfigTransport_retry(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	config := Config{
+		MaxRetries:   3,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	}
+	transport, err := config.transport()
+	if err != nil {
+		t.Fatalf("unexpected error building transport: %s", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to succeed after retrying, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestConfigTransport_noRetryOnServerError(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	transport, _ := (&Config{MaxRetries: 3, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}).transport()
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError || attempts != 1 {
+		t.Fatalf("expected a single attempt returning 500, got %d attempts returning %d", attempts, resp.StatusCode)
+	}
+}
+
+func TestConfigTransport_postRetries(t *testing.T) {
+	cases := map[int]int32{
+		// a POST may have been applied behind a failing gateway
+		http.StatusBadGateway: 1,
+		// a throttled POST was rejected by the appliance
+		http.StatusServiceUnavailable: 3,
+	}
+	for status, expected := range cases {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) < 3 {
+					w.WriteHeader(status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			transport, _ := (&Config{MaxRetries: 3, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}).transport()
+			resp, err := (&http.Client{Transport: transport}).Post(srv.URL, "application/json", strings.NewReader("{}"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+			if attempts != expected {
+				t.Fatalf("expected %d attempts, got %d", expected, attempts)
+			}
+		})
+	}
+}
+
+func TestConfigTransport_rateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	transport, _ := (&Config{RequestsPerSecond: 20}).transport()
+	client := &http.Client{Transport: transport}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected requests to be throttled to 20 per second, 3 requests took %s", elapsed)
+	}
+}
+
+func TestConfigClient_passwordLogin(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	// a token expiring within the refresh window is refreshed before the
+	// next request
+	srv.stub("POST", "/oauth/token", http.StatusOK, map[string]interface{}{
+		"access_token":  "acctest",
+		"refresh_token": "acctest-refresh",
+		"token_type":    "bearer",
+		"expires_in":    60,
+		"scope":         "write",
+	})
+
+	config := Config{
+		Url:      srv.URL,
+		Username: "admin",
+		Password: "password",
+		ClientId: "terraform",
+	}
+	client, diags := config.Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+	if _, err := client.ListEnvironments(&morpheus.Request{}); err != nil {
+		t.Fatalf("error listing environments: %s", err)
+	}
+
+	logins := srv.requests(http.MethodPost, "/oauth/token")
+	if len(logins) != 2 {
+		t.Fatalf("expected a login and a refresh, got %d token requests", len(logins))
+	}
+	if logins[0].Query != "client_id=terraform&grant_type=password&scope=write" {
+		t.Fatalf("unexpected login query %q", logins[0].Query)
+	}
+	if logins[1].Query != "client_id=terraform&grant_type=refresh_token&scope=write" {
+		t.Fatalf("unexpected refresh query %q", logins[1].Query)
+	}
+	if logins[1].RequestBody != "refresh_token=acctest-refresh" {
+		t.Fatalf("unexpected refresh body %v", logins[1].RequestBody)
+	}
+}
+
+func TestConfigClient_accessToken(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+
+	client, diags := (&Config{Url: srv.URL, AccessToken: "acctest"}).Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+	if _, err := client.ListEnvironments(&morpheus.Request{}); err != nil {
+		t.Fatalf("error listing environments: %s", err)
+	}
+
+	// an access token without a known expiry is used as is
+	if n := len(srv.requests(http.MethodPost, "/oauth/token")); n != 0 {
+		t.Fatalf("expected no token requests, got %d", n)
+	}
+}
+
+func TestConfigClient_expiredClientToken(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.stub("POST", "/oauth/token", http.StatusOK, map[string]interface{}{
+		"access_token":  "acctest",
+		"refresh_token": "acctest-refresh",
+		"token_type":    "bearer",
+		"expires_in":    1,
+		"scope":         "write",
+	})
+
+	client, diags := (&Config{Url: srv.URL, Username: "admin", Password: "password"}).Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	// once the token has expired it is refreshed by the token transport,
+	// the client does not log in again with the password
+	time.Sleep(1100 * time.Millisecond)
+	if _, err := client.ListEnvironments(&morpheus.Request{}); err != nil {
+		t.Fatalf("error listing environments: %s", err)
+	}
+	logins := 0
+	for _, r := range srv.requests(http.MethodPost, "/oauth/token") {
+		if strings.Contains(r.Query, "grant_type=password") {
+			logins++
+		}
+	}
+	if logins != 1 {
+		t.Fatalf("expected a single password login, got %d", logins)
+	}
+}
+
+func TestConfigClient_accessTokenFile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success": true}`))
+	}))
+	defer srv.Close()
+
+	tokenFile := t.TempDir() + "/token"
+	if err := os.WriteFile(tokenFile, []byte("expired\n"), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+	client, diags := (&Config{Url: srv.URL, AccessTokenFile: tokenFile}).Client()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	// the token is rotated outside of the provider and read again once
+	// Morpheus rejects the old one
+	if err := os.WriteFile(tokenFile, []byte("rotated\n"), 0600); err != nil {
+		t.Fatalf("error writing token file: %s", err)
+	}
+	resp, err := client.Execute(&morpheus.Request{Method: "GET", Path: "/api/whoami"})
+	if err != nil {
+		t.Fatalf("expected the request to succeed with the rotated token, got %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+}
@@ -78,7 +78,7 @@ func dataSourceMorpheusCloud() *schema.Resource {
 }
 
 func dataSourceMorpheusCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -48,7 +48,7 @@ func dataSourceMorpheusCloudFolder() *schema.Resource {
 }
 
 func dataSourceMorpheusCloudFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	id := d.Get("id").(int)
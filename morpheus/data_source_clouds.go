@@ -2,12 +2,13 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusClouds() *schema.Resource {
@@ -20,66 +21,90 @@ func dataSourceMorpheusClouds() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-			"sort_ascending": {
-				Type:        schema.TypeBool,
-				Description: "Whether to sort the IDs in ascending order",
-				Default:     true,
-				Optional:    true,
-			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
+			"clouds": {
+				Type:        schema.TypeList,
+				Description: "The clouds matching the filters, in the same order as the IDs",
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud",
+							Computed:    true,
+						},
 						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name"}, false),
+							Type:        schema.TypeString,
+							Description: "The name of the cloud",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the cloud",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the cloud",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"cloud_type": {
+							Type:        schema.TypeString,
+							Description: "The code of the cloud type, e.g. vmware or amazon",
+							Computed:    true,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Description: "The location of the cloud",
+							Computed:    true,
 						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+						"region_code": {
+							Type:        schema.TypeString,
+							Description: "The region code of the cloud",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the cloud is enabled",
+							Computed:    true,
+						},
+						"visibility": {
+							Type:        schema.TypeString,
+							Description: "The visibility of the cloud",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant that owns the cloud",
+							Computed:    true,
 						},
 					},
 				},
 			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(true, "name", "code", "labels", "type", "tenant", "enabled"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusCloudsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var names []string
 
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			filterPayload := filter.(map[string]interface{})
-
-			if filterPayload["name"].(string) == "name" {
-				for _, item := range filterPayload["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort clouds in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -88,40 +113,95 @@ func dataSourceMorpheusCloudsRead(ctx context.Context, d *schema.ResourceData, m
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListClouds(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "250",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
-
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
-			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
-		}
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
 	}
-	log.Printf("API RESPONSE: %s", resp)
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name", "type": "type", "labels": "labels"}, params)
 
 	cloudIDs := []int64{}
+	cloudResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListClouds(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return 0, 0, err
+		}
+		log.Printf("API RESPONSE: %s", resp)
 
-	// store resource data
-	result := resp.Result.(*morpheus.ListCloudsResult)
-	clouds := result.Clouds
-	for _, cloud := range *clouds {
-		if len(names) > 0 {
-			if regexCheck(names, cloud.Name) {
+		var cloudsPayload CloudsPayload
+		if err := json.Unmarshal(resp.Body, &cloudsPayload); err != nil {
+			return 0, 0, err
+		}
+		clouds := cloudsPayload.Zones
+		matched := 0
+		for _, cloud := range clouds {
+			attributes := addTagFilterAttributes(map[string][]string{
+				"name":    {cloud.Name},
+				"code":    {cloud.Code},
+				"labels":  cloud.Labels,
+				"type":    {cloud.ZoneType.Code},
+				"tenant":  tenantFilterValues(cloud.Account.ID, cloud.Account.Name),
+				"enabled": {strconv.FormatBool(cloud.Enabled)},
+			}, cloud.Tags)
+			if matchDataSourceFilters(filters, filterMode, attributes) {
 				cloudIDs = append(cloudIDs, cloud.ID)
+				cloudResults = append(cloudResults, map[string]interface{}{
+					"id":          cloud.ID,
+					"name":        cloud.Name,
+					"code":        cloud.Code,
+					"labels":      cloud.Labels,
+					"cloud_type":  cloud.ZoneType.Code,
+					"location":    cloud.Location,
+					"region_code": cloud.RegionCode,
+					"enabled":     cloud.Enabled,
+					"visibility":  cloud.Visibility,
+					"tenant_id":   cloud.Account.ID,
+				})
+				matched++
 			}
-		} else {
-			cloudIDs = append(cloudIDs, cloud.ID)
 		}
+		return len(clouds), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(cloudIDs) > max {
+		cloudIDs = cloudIDs[:max]
+		cloudResults = cloudResults[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", cloudIDs)
+	d.Set("clouds", cloudResults)
 	return diags
 }
+
+type CloudsPayload struct {
+	Zones []struct {
+		ID         int64    `json:"id"`
+		Name       string   `json:"name"`
+		Code       string   `json:"code"`
+		Labels     []string `json:"labels"`
+		Location   string   `json:"location"`
+		RegionCode string   `json:"regionCode"`
+		Enabled    bool     `json:"enabled"`
+		Visibility string   `json:"visibility"`
+		ZoneType   struct {
+			Code string `json:"code"`
+		} `json:"zoneType"`
+		Account struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"account"`
+		Tags []dataSourceTag `json:"tags"`
+	} `json:"zones"`
+}
@@ -0,0 +1,166 @@
+package morpheus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMorpheusCloudsDataSource_pagination(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	for i := 0; i < 2*dataSourcePageSize+10; i++ {
+		srv.seed("/api/zones", "zone", map[string]interface{}{
+			"name":     fmt.Sprintf("tfacc-%d", i),
+			"code":     fmt.Sprintf("tfacc%d", i),
+			"labels":   []string{"tfacc"},
+			"zoneType": map[string]interface{}{"code": "vmware"},
+			"enabled":  true,
+		})
+	}
+	dataSourceName := "data.morpheus_clouds.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusCloudsDataSourceConfig(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", fmt.Sprint(2*dataSourcePageSize+10)),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.0", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.#", fmt.Sprint(2*dataSourcePageSize+10)),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.name", "tfacc-0"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.code", "tfacc0"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.labels.0", "tfacc"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.cloud_type", "vmware"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.enabled", "true"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusCloudsDataSourceConfig(5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.4", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusCloudsDataSource_filter(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.seed("/api/zones", "zone", map[string]interface{}{
+		"name":     "tfacc-vmware",
+		"labels":   []string{"prod"},
+		"zoneType": map[string]interface{}{"code": "vmware"},
+		"tags":     []map[string]interface{}{{"name": "owner", "value": "platform"}},
+	})
+	srv.seed("/api/zones", "zone", map[string]interface{}{
+		"name":     "tfacc-amazon",
+		"labels":   []string{"dev"},
+		"zoneType": map[string]interface{}{"code": "amazon"},
+	})
+	dataSourceName := "data.morpheus_clouds.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_clouds" "tfacc" {
+  filter {
+    name   = "labels"
+    values = ["prod"]
+  }
+  filter {
+    name   = "tag:owner"
+    values = ["^platform$"]
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.name", "tfacc-vmware"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_clouds" "tfacc" {
+  filter_mode = "or"
+  filter {
+    name   = "type"
+    values = ["amazon"]
+  }
+  filter {
+    name   = "labels"
+    values = ["prod"]
+  }
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_clouds" "tfacc" {
+  filter {
+    name   = "name"
+    values = ["^tfacc-amazon$"]
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "clouds.0.name", "tfacc-amazon"),
+					func(*terraform.State) error {
+						for _, request := range srv.requests("GET", "/api/zones") {
+							if strings.Contains(request.Query, "name=tfacc-amazon") {
+								return nil
+							}
+						}
+						return fmt.Errorf("expected the exact name filter to be sent to the API")
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestListAllPages(t *testing.T) {
+	pages := []int{dataSourcePageSize, dataSourcePageSize, 3}
+	var offsets []string
+	err := listAllPages(map[string]string{}, 0, func(params map[string]string) (int, int, error) {
+		offsets = append(offsets, params["offset"])
+		size := pages[len(offsets)-1]
+		return size, size, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := fmt.Sprint(offsets), fmt.Sprintf("[0 %d %d]", dataSourcePageSize, 2*dataSourcePageSize); got != want {
+		t.Fatalf("expected offsets %s, got %s", want, got)
+	}
+
+	calls := 0
+	err = listAllPages(map[string]string{}, 10, func(params map[string]string) (int, int, error) {
+		calls++
+		return dataSourcePageSize, 10, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected paging to stop once max_results matches were found, got %d calls", calls)
+	}
+}
+
+func testAccMorpheusCloudsDataSourceConfig(maxResults int) string {
+	return fmt.Sprintf(`
+data "morpheus_clouds" "tfacc" {
+  max_results = %d
+}
+`, maxResults)
+}
@@ -40,7 +40,7 @@ func dataSourceMorpheusCypherSecret() *schema.Resource {
 }
 
 func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -69,12 +69,7 @@ func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceD
 	cypher := resp.Result.(*LocalGetCypherResult)
 	if cypher != nil {
 		d.SetId(int64ToString(cypher.Cypher.ID))
-		if cypher.Type == "object" {
-			jsonPayload, _ := json.Marshal(cypher.Data)
-			d.Set("value", string(jsonPayload))
-		} else {
-			d.Set("value", cypher.Data.(string))
-		}
+		d.Set("value", cypherSecretValue(cypher))
 		d.Set("ttl", cypher.LeaseDuration)
 	} else {
 		return diag.Errorf("Cypher secret not found in response data.") // should not happen
@@ -82,6 +77,17 @@ func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+// cypherSecretValue returns the value of a cypher secret, the value of an
+// object secret is returned as JSON.
+func cypherSecretValue(cypher *LocalGetCypherResult) string {
+	if cypher.Type == "object" {
+		jsonPayload, _ := json.Marshal(cypher.Data)
+		return string(jsonPayload)
+	}
+	value, _ := cypher.Data.(string)
+	return value
+}
+
 type LocalGetCypherResult struct {
 	Success       bool              `json:"success"`
 	Data          interface{}       `json:"data"`
@@ -0,0 +1,165 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusCypherSecrets() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus cypher secrets data source, which lists the keys under a cypher path and reads their values.",
+		ReadContext: dataSourceMorpheusCypherSecretsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "The cypher path to list the keys of, including the mount, such as secret/app1 or tfvars",
+				Required:    true,
+			},
+			"recursive": {
+				Type:        schema.TypeBool,
+				Description: "Whether to list the keys of the paths nested under the prefix",
+				Optional:    true,
+				Default:     false,
+			},
+			"read_values": {
+				Type:        schema.TypeBool,
+				Description: "Whether to read the value of each key, reading a key of a generated mount such as password generates its value",
+				Optional:    true,
+				Default:     true,
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Description: "The keys under the prefix, relative to the prefix",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Description: "The values of the keys under the prefix, keyed by the key relative to the prefix. Object values are JSON encoded",
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusCypherSecretsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	prefix := strings.Trim(strings.TrimSuffix(d.Get("prefix").(string), "*"), "/")
+	keys, err := listCypherKeys(client, prefix, "", d.Get("recursive").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := make(map[string]interface{})
+	if d.Get("read_values").(bool) {
+		for _, key := range keys {
+			value, err := readCypherValue(client, fmt.Sprintf("%s/%s", prefix, key))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			values[key] = value
+		}
+	}
+
+	d.SetId(prefix)
+	d.Set("keys", keys)
+	d.Set("values", values)
+	return diags
+}
+
+// listCypherKeys returns the keys under the cypher path prefix/path relative
+// to prefix. Keys ending in a slash are nested paths, which are traversed when
+// recursive is set and skipped otherwise.
+func listCypherKeys(client *morpheus.Client, prefix, path string, recursive bool) ([]string, error) {
+	listPath := prefix
+	if path != "" {
+		listPath = fmt.Sprintf("%s/%s", prefix, path)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/cypher/%s", listPath),
+		QueryParams: map[string]string{
+			"list": "true",
+		},
+		Result: &LocalListCypherResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return []string{}, nil
+		}
+		log.Printf("API FAILURE: %s - %v", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	keys := []string{}
+	for _, key := range resp.Result.(*LocalListCypherResult).Data.Keys {
+		if path != "" {
+			key = fmt.Sprintf("%s/%s", path, key)
+		}
+		if !strings.HasSuffix(key, "/") {
+			keys = append(keys, key)
+			continue
+		}
+		if recursive {
+			nestedKeys, err := listCypherKeys(client, prefix, strings.TrimSuffix(key, "/"), recursive)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, nestedKeys...)
+		}
+	}
+	return keys, nil
+}
+
+// readCypherValue returns the value of the cypher at path, with object values
+// encoded as JSON.
+func readCypherValue(client *morpheus.Client, path string) (string, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/cypher/%s", path),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %v", resp, err)
+		return "", err
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	cypher := resp.Result.(*LocalGetCypherResult)
+	if value, ok := cypher.Data.(string); ok {
+		return value, nil
+	}
+	jsonPayload, err := json.Marshal(cypher.Data)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonPayload), nil
+}
+
+type LocalListCypherResult struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Keys []string `json:"keys"`
+	} `json:"data"`
+	Message string `json:"msg"`
+}
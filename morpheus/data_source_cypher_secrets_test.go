@@ -0,0 +1,65 @@
+package morpheus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusCypherSecretsDataSource_recursive(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.stub("GET", "/api/cypher/secret/app1", http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data":    map[string]interface{}{"keys": []string{"database", "nested/"}},
+	})
+	srv.stub("GET", "/api/cypher/secret/app1/nested", http.StatusOK, map[string]interface{}{
+		"success": true,
+		"data":    map[string]interface{}{"keys": []string{"token"}},
+	})
+	srv.stub("GET", "/api/cypher/secret/app1/database", http.StatusOK, map[string]interface{}{
+		"success": true,
+		"type":    "object",
+		"data":    map[string]interface{}{"username": "app1"},
+		"cypher":  map[string]interface{}{"id": 1, "itemKey": "secret/app1/database"},
+	})
+	srv.stub("GET", "/api/cypher/secret/app1/nested/token", http.StatusOK, map[string]interface{}{
+		"success": true,
+		"type":    "string",
+		"data":    "token123",
+		"cypher":  map[string]interface{}{"id": 2, "itemKey": "secret/app1/nested/token"},
+	})
+	dataSourceName := "data.morpheus_cypher_secrets.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_cypher_secrets" "tfacc" {
+  prefix = "secret/app1/*"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "secret/app1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "database"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.database", `{"username":"app1"}`),
+				),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_cypher_secrets" "tfacc" {
+  prefix    = "secret/app1"
+  recursive = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "nested/token"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.nested/token", "token123"),
+				),
+			},
+		},
+	})
+}
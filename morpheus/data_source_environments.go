@@ -2,7 +2,9 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,56 +21,81 @@ func dataSourceMorpheusEnvironments() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
+			"environments": {
+				Type:        schema.TypeList,
+				Description: "The environments matching the filters, in the same order as the IDs",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the environment",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the environment",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the environment",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the environment",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the environment",
+							Computed:    true,
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Description: "Whether the environment is active",
+							Computed:    true,
+						},
+						"visibility": {
+							Type:        schema.TypeString,
+							Description: "The visibility of the environment",
+							Computed:    true,
+						},
+						"sort_order": {
+							Type:        schema.TypeInt,
+							Description: "The sort order of the environment",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"max_results": maxResultsSchema(),
 			"sort_ascending": {
 				Type:        schema.TypeBool,
 				Description: "Whether to sort the IDs in ascending order",
 				Default:     true,
 				Optional:    true,
 			},
-			/*
-				"filter": {
-					Type:        schema.TypeSet,
-					Description: "The environment variables to create",
-					Optional:    true,
-					MaxItems:    1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"name": {
-								Type:        schema.TypeString,
-								Description: "The name of the environment variable",
-								Required:    true,
-							},
-							"values": {
-								Type:     schema.TypeSet,
-								Required: true,
-								Elem: &schema.Schema{
-									Type: schema.TypeString,
-								},
-							},
-						},
-					},
-				},
-			*/
+			"filter":      dataSourceFilterSchema(false, "name", "code", "labels", "active"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	/*
-		payload := d.Get("filter").(*schema.Set).List()[0]
-		test := payload.(map[string]interface{})
 
-		log.Printf("FILTER INFO - %s - ", test["name"].(string))
-		log.Printf("FILTER INFO - %v -", test["values"].(*schema.Set).List())
-	*/
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
+
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
 		sortOrder = "asc"
@@ -76,34 +103,83 @@ func dataSourceMorpheusEnvironmentsRead(ctx context.Context, d *schema.ResourceD
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListEnvironments(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "50",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+	}
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
 
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	environmentIDs := []int64{}
+	environmentResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListEnvironments(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
+		log.Printf("API RESPONSE: %s", resp)
 
-	environmentIDs := []int64{}
+		var environmentsPayload EnvironmentsPayload
+		if err := json.Unmarshal(resp.Body, &environmentsPayload); err != nil {
+			return 0, 0, err
+		}
+		environments := environmentsPayload.Environments
+		matched := 0
+		for _, environment := range environments {
+			attributes := map[string][]string{
+				"name":   {environment.Name},
+				"code":   {environment.Code},
+				"labels": environment.Labels,
+				"active": {strconv.FormatBool(environment.Active)},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				environmentIDs = append(environmentIDs, environment.ID)
+				environmentResults = append(environmentResults, map[string]interface{}{
+					"id":          environment.ID,
+					"name":        environment.Name,
+					"code":        environment.Code,
+					"labels":      environment.Labels,
+					"description": environment.Description,
+					"active":      environment.Active,
+					"visibility":  environment.Visibility,
+					"sort_order":  environment.SortOrder,
+				})
+				matched++
+			}
+		}
+		return len(environments), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	// store resource data
-	result := resp.Result.(*morpheus.ListEnvironmentsResult)
-	environments := result.Environments
-	for _, environment := range *environments {
-		environmentIDs = append(environmentIDs, environment.ID)
+	if max := d.Get("max_results").(int); max > 0 && len(environmentIDs) > max {
+		environmentIDs = environmentIDs[:max]
+		environmentResults = environmentResults[:max]
 	}
+
 	d.SetId("1")
 	d.Set("ids", environmentIDs)
+	d.Set("environments", environmentResults)
 	return diags
 }
+
+type EnvironmentsPayload struct {
+	Environments []struct {
+		ID          int64    `json:"id"`
+		Name        string   `json:"name"`
+		Code        string   `json:"code"`
+		Labels      []string `json:"labels"`
+		Description string   `json:"description"`
+		Active      bool     `json:"active"`
+		Visibility  string   `json:"visibility"`
+		SortOrder   int64    `json:"sortOrder"`
+	} `json:"environments"`
+}
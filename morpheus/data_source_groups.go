@@ -2,6 +2,7 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"regexp"
 	"strconv"
@@ -9,7 +10,6 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusGroups() *schema.Resource {
@@ -22,76 +22,71 @@ func dataSourceMorpheusGroups() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"sort_ascending": {
-				Type:        schema.TypeBool,
-				Description: "Whether to sort the IDs in ascending order. Defaults to true",
-				Default:     true,
-				Optional:    true,
-			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "The groups matching the filters, in the same order as the IDs",
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the group",
+							Computed:    true,
+						},
 						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name, location)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name", "location"}, false),
+							Type:        schema.TypeString,
+							Description: "The name of the group",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the group",
+							Computed:    true,
 						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the group",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Description: "The location of the group",
+							Computed:    true,
+						},
+						"cloud_ids": {
+							Type:        schema.TypeList,
+							Description: "The IDs of the clouds associated with the group",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
 						},
 					},
 				},
 			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order. Defaults to true",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(false, "name", "code", "labels", "location"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var locations []string
-	var names []string
-
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			test := filter.(map[string]interface{})
-			if test["name"].(string) == "location" {
-				for _, item := range test["values"].(*schema.Set).List() {
-					locations = append(locations, item.(string))
-				}
-			}
-
-			if test["name"].(string) == "name" {
-				for _, item := range test["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(locations) == 0 {
-		locations = append(locations, "$")
-	}
 
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -100,37 +95,73 @@ func dataSourceMorpheusGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListGroups(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "50",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
-
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+	}
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
+
+	groupIDs := []string{}
+	groupResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListGroups(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
-
-	var groupIDs []string
+		log.Printf("API RESPONSE: %s", resp)
 
-	// store resource data
-	result := resp.Result.(*morpheus.ListGroupsResult)
-	groups := result.Groups
-	for _, group := range *groups {
-		if regexCheck(locations, group.Location) && regexCheck(names, group.Name) {
-			groupIDs = append(groupIDs, strconv.Itoa(int(group.ID)))
+		var groupsPayload GroupsPayload
+		if err := json.Unmarshal(resp.Body, &groupsPayload); err != nil {
+			return 0, 0, err
 		}
+		groups := groupsPayload.Groups
+		matched := 0
+		for _, group := range groups {
+			attributes := map[string][]string{
+				"name":     {group.Name},
+				"code":     {group.Code},
+				"labels":   group.Labels,
+				"location": {group.Location},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				groupIDs = append(groupIDs, strconv.Itoa(int(group.ID)))
+				cloudIDs := []int64{}
+				for _, zone := range group.Zones {
+					cloudIDs = append(cloudIDs, zone.ID)
+				}
+				groupResults = append(groupResults, map[string]interface{}{
+					"id":        group.ID,
+					"name":      group.Name,
+					"code":      group.Code,
+					"labels":    group.Labels,
+					"location":  group.Location,
+					"cloud_ids": cloudIDs,
+				})
+				matched++
+			}
+		}
+		return len(groups), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(groupIDs) > max {
+		groupIDs = groupIDs[:max]
+		groupResults = groupResults[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", groupIDs)
+	d.Set("groups", groupResults)
 	return diags
 }
 
@@ -149,3 +180,16 @@ func regexCheck(s []string, str string) bool {
 		return false
 	}
 }
+
+type GroupsPayload struct {
+	Groups []struct {
+		ID       int64    `json:"id"`
+		Name     string   `json:"name"`
+		Code     string   `json:"code"`
+		Labels   []string `json:"labels"`
+		Location string   `json:"location"`
+		Zones    []struct {
+			ID int64 `json:"id"`
+		} `json:"zones"`
+	} `json:"groups"`
+}
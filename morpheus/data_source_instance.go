@@ -0,0 +1,594 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusInstance() *schema.Resource {
+	instanceSchema := instanceDataSourceAttributes()
+	instanceSchema["id"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "The ID of the instance",
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	instanceSchema["name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The name of the instance",
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"id"},
+	}
+	instanceSchema["labels"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "The labels associated with the instance. When set, the instance must have every label",
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	instanceSchema["tags"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "The tags associated with the instance. When set, the instance must have every tag",
+		Optional:    true,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Description: "Provides a Morpheus instance data source.",
+		ReadContext: dataSourceMorpheusInstanceRead,
+		Schema:      instanceSchema,
+	}
+}
+
+func dataSourceMorpheusInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := int64(d.Get("id").(int))
+	name := d.Get("name").(string)
+	labels := d.Get("labels").(*schema.Set).List()
+	tags := d.Get("tags").(map[string]interface{})
+
+	// lookup by name, labels and tags if we do not have an id yet
+	if id == 0 {
+		if name == "" && len(labels) == 0 && len(tags) == 0 {
+			return diag.Errorf("Instance cannot be read without name, id, labels or tags")
+		}
+		params := map[string]string{}
+		if name != "" {
+			params["name"] = name
+		}
+		// Stop paging once a second match shows the lookup is ambiguous
+		var matches []int64
+		err := listAllPages(params, 2, func(params map[string]string) (int, int, error) {
+			resp, err := client.ListInstances(&morpheus.Request{
+				QueryParams: params,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					log.Printf("API 404: %s - %v", resp, err)
+					return 0, 0, nil
+				}
+				log.Printf("API FAILURE: %s - %v", resp, err)
+				return 0, 0, err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+
+			var instancesPayload InstancesPayload
+			if err := json.Unmarshal(resp.Body, &instancesPayload); err != nil {
+				return 0, 0, err
+			}
+			matched := 0
+			for _, instance := range instancesPayload.Instances {
+				if (name == "" || instance.Name == name) && instanceHasLabels(instance, labels) && instanceHasTags(instance, tags) {
+					matches = append(matches, instance.ID)
+					matched++
+				}
+			}
+			return len(instancesPayload.Instances), matched, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("Instance not found")
+		case 1:
+			id = matches[0]
+		default:
+			return diag.Errorf("Found more than one instance matching the lookup, use more specific criteria")
+		}
+	}
+
+	resp, err := client.GetInstance(id, &morpheus.Request{
+		QueryParams: map[string]string{
+			"details": "true",
+		},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %v", resp, err)
+			return diag.Errorf("Instance %d not found", id)
+		} else {
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var instancePayload InstancePayload
+	if err := json.Unmarshal(resp.Body, &instancePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	instance := instancePayload.Instance
+	d.SetId(int64ToString(instance.ID))
+	for key, value := range flattenInstanceDetails(instance) {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+	return diags
+}
+
+// instanceDataSourceAttributes returns the computed attributes of an
+// instance, shared by the instance data sources.
+func instanceDataSourceAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the instance",
+			Computed:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the instance",
+			Computed:    true,
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The description of the instance",
+			Computed:    true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the instance",
+			Computed:    true,
+		},
+		"labels": {
+			Type:        schema.TypeSet,
+			Description: "The labels associated with the instance",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": {
+			Type:        schema.TypeMap,
+			Description: "The tags associated with the instance",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"environment": {
+			Type:        schema.TypeString,
+			Description: "The environment of the instance",
+			Computed:    true,
+		},
+		"cloud_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the cloud of the instance",
+			Computed:    true,
+		},
+		"cloud_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the cloud of the instance",
+			Computed:    true,
+		},
+		"group_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the group of the instance",
+			Computed:    true,
+		},
+		"group_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the group of the instance",
+			Computed:    true,
+		},
+		"plan_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the service plan of the instance",
+			Computed:    true,
+		},
+		"plan_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the service plan of the instance",
+			Computed:    true,
+		},
+		"instance_type_code": {
+			Type:        schema.TypeString,
+			Description: "The code of the instance type of the instance",
+			Computed:    true,
+		},
+		"instance_layout_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the layout of the instance",
+			Computed:    true,
+		},
+		"server_ids": {
+			Type:        schema.TypeList,
+			Description: "The IDs of the servers (hosts or virtual machines) of the instance",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"container_ids": {
+			Type:        schema.TypeList,
+			Description: "The IDs of the containers (nodes) of the instance",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"interfaces": {
+			Type:        schema.TypeList,
+			Description: "The network interfaces of the instance",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the network interface",
+						Computed:    true,
+					},
+					"network_id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the network of the network interface",
+						Computed:    true,
+					},
+					"network_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the network of the network interface",
+						Computed:    true,
+					},
+					"ip_address": {
+						Type:        schema.TypeString,
+						Description: "The IP address of the network interface",
+						Computed:    true,
+					},
+					"ip_mode": {
+						Type:        schema.TypeString,
+						Description: "The IP mode of the network interface",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"volumes": {
+			Type:        schema.TypeList,
+			Description: "The volumes of the instance",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the volume",
+						Computed:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the volume",
+						Computed:    true,
+					},
+					"root": {
+						Type:        schema.TypeBool,
+						Description: "Whether the volume is the root volume of the instance",
+						Computed:    true,
+					},
+					"size": {
+						Type:        schema.TypeInt,
+						Description: "The size of the volume in GB",
+						Computed:    true,
+					},
+					"storage_type": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the storage type of the volume",
+						Computed:    true,
+					},
+					"datastore_id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the datastore of the volume",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"connection_info": {
+			Type:        schema.TypeList,
+			Description: "The connection information of the instance",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:        schema.TypeString,
+						Description: "The IP address to connect to",
+						Computed:    true,
+					},
+					"port": {
+						Type:        schema.TypeInt,
+						Description: "The port to connect to",
+						Computed:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the connection protocol",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"containers": {
+			Type:        schema.TypeList,
+			Description: "The containers (nodes) of the instance and the servers they run on",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the container",
+						Computed:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: "The name of the container",
+						Computed:    true,
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Description: "The internal IP address of the container",
+						Computed:    true,
+					},
+					"external_ip": {
+						Type:        schema.TypeString,
+						Description: "The external IP address of the container",
+						Computed:    true,
+					},
+					"hostname": {
+						Type:        schema.TypeString,
+						Description: "The hostname of the container",
+						Computed:    true,
+					},
+					"server_id": {
+						Type:        schema.TypeInt,
+						Description: "The ID of the server the container runs on",
+						Computed:    true,
+					},
+					"server_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the server the container runs on",
+						Computed:    true,
+					},
+					"server_external_id": {
+						Type:        schema.TypeString,
+						Description: "The ID of the server in the cloud, such as the AWS instance ID",
+						Computed:    true,
+					},
+					"server_os_type": {
+						Type:        schema.TypeString,
+						Description: "The operating system type of the server",
+						Computed:    true,
+					},
+					"server_power_state": {
+						Type:        schema.TypeString,
+						Description: "The power state of the server",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenInstanceDetails returns the attributes of the instance data
+// sources for an instance.
+func flattenInstanceDetails(instance InstanceDetails) map[string]interface{} {
+	tags := make(map[string]interface{})
+	for _, tag := range instance.Tags {
+		tags[tag.Name] = tag.Value
+	}
+
+	var interfaces []map[string]interface{}
+	for _, networkInterface := range instance.Interfaces {
+		interfaces = append(interfaces, map[string]interface{}{
+			"id":           apiInt64(networkInterface.ID),
+			"network_id":   networkInterface.Network.ID,
+			"network_name": networkInterface.Network.Name,
+			"ip_address":   networkInterface.IpAddress,
+			"ip_mode":      networkInterface.IpMode,
+		})
+	}
+
+	var volumes []map[string]interface{}
+	for _, volume := range instance.Volumes {
+		volumes = append(volumes, map[string]interface{}{
+			"id":           apiInt64(volume.ID),
+			"name":         volume.Name,
+			"root":         volume.RootVolume,
+			"size":         apiInt64(volume.Size),
+			"storage_type": apiInt64(volume.StorageType),
+			"datastore_id": apiInt64(volume.DatastoreId),
+		})
+	}
+
+	var connectionInfo []map[string]interface{}
+	for _, connection := range instance.ConnectionInfo {
+		connectionInfo = append(connectionInfo, map[string]interface{}{
+			"ip":   connection.Ip,
+			"port": apiInt64(connection.Port),
+			"name": connection.Name,
+		})
+	}
+
+	var containers []map[string]interface{}
+	for _, container := range instance.ContainerDetails {
+		containers = append(containers, map[string]interface{}{
+			"id":                 container.ID,
+			"name":               container.Name,
+			"ip":                 container.Ip,
+			"external_ip":        container.ExternalIp,
+			"hostname":           container.InternalHostname,
+			"server_id":          container.Server.ID,
+			"server_name":        container.Server.Name,
+			"server_external_id": container.Server.ExternalId,
+			"server_os_type":     container.Server.OsType,
+			"server_power_state": container.Server.PowerState,
+		})
+	}
+
+	return map[string]interface{}{
+		"id":                 instance.ID,
+		"name":               instance.Name,
+		"description":        instance.Description,
+		"status":             instance.Status,
+		"labels":             instance.Labels,
+		"tags":               tags,
+		"environment":        instance.InstanceContext,
+		"cloud_id":           instance.Cloud.ID,
+		"cloud_name":         instance.Cloud.Name,
+		"group_id":           instance.Group.ID,
+		"group_name":         instance.Group.Name,
+		"plan_id":            instance.Plan.ID,
+		"plan_name":          instance.Plan.Name,
+		"instance_type_code": instance.InstanceType.Code,
+		"instance_layout_id": instance.Layout.ID,
+		"server_ids":         instance.Servers,
+		"container_ids":      instance.Containers,
+		"interfaces":         interfaces,
+		"volumes":            volumes,
+		"connection_info":    connectionInfo,
+		"containers":         containers,
+	}
+}
+
+// instanceHasLabels reports whether an instance has every label.
+func instanceHasLabels(instance InstanceDetails, labels []interface{}) bool {
+	for _, label := range labels {
+		if !containsString(instance.Labels, label.(string)) {
+			return false
+		}
+	}
+	return true
+}
+
+// instanceHasTags reports whether an instance has every tag.
+func instanceHasTags(instance InstanceDetails, tags map[string]interface{}) bool {
+	for key, value := range tags {
+		found := false
+		for _, tag := range instance.Tags {
+			if tag.Name == key && tag.Value == value.(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// instanceFilterAttributes returns the values the filters of the instances
+// data source match against.
+func instanceFilterAttributes(instance InstanceDetails) map[string][]string {
+	return addTagFilterAttributes(map[string][]string{
+		"name":   {instance.Name},
+		"labels": instance.Labels,
+		"status": {instance.Status},
+		"cloud":  {fmt.Sprintf("%d", instance.Cloud.ID), instance.Cloud.Name},
+		"group":  {fmt.Sprintf("%d", instance.Group.ID), instance.Group.Name},
+		"type":   {instance.InstanceType.Code},
+	}, instance.Tags)
+}
+
+type InstanceDetails struct {
+	ID              int64           `json:"id"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description"`
+	Status          string          `json:"status"`
+	Labels          []string        `json:"labels"`
+	Tags            []dataSourceTag `json:"tags"`
+	InstanceContext string          `json:"instanceContext"`
+	Cloud           struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"cloud"`
+	Group struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"group"`
+	Plan struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"plan"`
+	InstanceType struct {
+		ID   int64  `json:"id"`
+		Code string `json:"code"`
+	} `json:"instanceType"`
+	Layout struct {
+		ID int64 `json:"id"`
+	} `json:"layout"`
+	Servers    []int64 `json:"servers"`
+	Containers []int64 `json:"containers"`
+	Interfaces []struct {
+		ID      interface{} `json:"id"`
+		Network struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"network"`
+		IpAddress string `json:"ipAddress"`
+		IpMode    string `json:"ipMode"`
+	} `json:"interfaces"`
+	Volumes []struct {
+		ID          interface{} `json:"id"`
+		Name        string      `json:"name"`
+		RootVolume  bool        `json:"rootVolume"`
+		Size        interface{} `json:"size"`
+		StorageType interface{} `json:"storageType"`
+		DatastoreId interface{} `json:"datastoreId"`
+	} `json:"volumes"`
+	ConnectionInfo []struct {
+		Ip   string      `json:"ip"`
+		Port interface{} `json:"port"`
+		Name string      `json:"name"`
+	} `json:"connectionInfo"`
+	ContainerDetails []struct {
+		ID               int64  `json:"id"`
+		Name             string `json:"name"`
+		Ip               string `json:"ip"`
+		ExternalIp       string `json:"externalIp"`
+		InternalHostname string `json:"internalHostname"`
+		Server           struct {
+			ID         int64  `json:"id"`
+			Name       string `json:"name"`
+			ExternalId string `json:"externalId"`
+			OsType     string `json:"osType"`
+			PowerState string `json:"powerState"`
+		} `json:"server"`
+	} `json:"containerDetails"`
+}
+
+type InstancePayload struct {
+	Instance InstanceDetails `json:"instance"`
+}
+
+type InstancesPayload struct {
+	Instances []InstanceDetails `json:"instances"`
+}
@@ -0,0 +1,128 @@
+package morpheus
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusInstanceDataSource_lookup(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.seed("/api/instances", "instance", map[string]interface{}{
+		"name":         "tfacc-web",
+		"status":       "running",
+		"labels":       []string{"web"},
+		"tags":         []map[string]interface{}{{"name": "environment", "value": "production"}},
+		"cloud":        map[string]interface{}{"id": 1, "name": "vsphere"},
+		"group":        map[string]interface{}{"id": 2, "name": "platform"},
+		"instanceType": map[string]interface{}{"code": "ubuntu"},
+		"servers":      []int64{10},
+		"containers":   []int64{20},
+	})
+	srv.seed("/api/instances", "instance", map[string]interface{}{
+		"name":   "tfacc-db",
+		"status": "stopped",
+		"labels": []string{"db"},
+		"cloud":  map[string]interface{}{"id": 1, "name": "vsphere"},
+	})
+	dataSourceName := "data.morpheus_instance.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_instance" "tfacc" {
+  name = "tfacc-web"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "cloud_name", "vsphere"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_id", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type_code", "ubuntu"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_ids.0", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.environment", "production"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_instance" "tfacc" {
+  labels = ["db"]
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "name", "tfacc-db"),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_instance" "tfacc" {
+  tags = {
+    environment = "staging"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Instance not found"),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusInstancesDataSource_filter(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.seed("/api/instances", "instance", map[string]interface{}{
+		"name":   "tfacc-web-1",
+		"status": "running",
+		"labels": []string{"web"},
+		"cloud":  map[string]interface{}{"id": 1, "name": "vsphere"},
+	})
+	srv.seed("/api/instances", "instance", map[string]interface{}{
+		"name":   "tfacc-web-2",
+		"status": "stopped",
+		"labels": []string{"web"},
+		"cloud":  map[string]interface{}{"id": 1, "name": "vsphere"},
+	})
+	srv.seed("/api/instances", "instance", map[string]interface{}{
+		"name":   "tfacc-db",
+		"status": "running",
+		"labels": []string{"db"},
+		"cloud":  map[string]interface{}{"id": 3, "name": "amazon"},
+	})
+	dataSourceName := "data.morpheus_instances.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_instances" "tfacc" {
+  filter {
+    name   = "labels"
+    values = ["web"]
+  }
+  filter {
+    name   = "status"
+    values = ["running"]
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.name", "tfacc-web-1"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "morpheus_instances" "tfacc" {
+  filter {
+    name   = "cloud"
+    values = ["vsphere"]
+  }
+}
+`,
+				Check: resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+			},
+		},
+	})
+}
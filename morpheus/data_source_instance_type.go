@@ -51,7 +51,7 @@ func dataSourceMorpheusInstanceType() *schema.Resource {
 }
 
 func dataSourceMorpheusInstanceTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
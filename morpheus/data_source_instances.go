@@ -0,0 +1,115 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusInstances() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Morpheus instances data source.",
+		ReadContext: dataSourceMorpheusInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Description: "The instances matching the filters, in the same order as the IDs",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: instanceDataSourceAttributes(),
+				},
+			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(true, "name", "labels", "status", "cloud", "group", "type"),
+			"filter_mode": dataSourceFilterModeSchema(),
+		},
+	}
+}
+
+func dataSourceMorpheusInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	var err error
+	var sortOrder string
+
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
+
+	// Sort instances in ascending or descending order
+	if d.Get("sort_ascending").(bool) {
+		sortOrder = "asc"
+	} else {
+		sortOrder = "desc"
+	}
+
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+		"details":   "true",
+	}
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name", "labels": "labels", "status": "status"}, params)
+
+	instanceIDs := []int64{}
+	instanceResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListInstances(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return 0, 0, err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+
+		var instancesPayload InstancesPayload
+		if err := json.Unmarshal(resp.Body, &instancesPayload); err != nil {
+			return 0, 0, err
+		}
+		instances := instancesPayload.Instances
+		matched := 0
+		for _, instance := range instances {
+			if matchDataSourceFilters(filters, filterMode, instanceFilterAttributes(instance)) {
+				instanceIDs = append(instanceIDs, instance.ID)
+				instanceResults = append(instanceResults, flattenInstanceDetails(instance))
+				matched++
+			}
+		}
+		return len(instances), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(instanceIDs) > max {
+		instanceIDs = instanceIDs[:max]
+		instanceResults = instanceResults[:max]
+	}
+
+	d.SetId("1")
+	d.Set("ids", instanceIDs)
+	d.Set("instances", instanceResults)
+	return diags
+}
@@ -2,13 +2,13 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusNetworks() *schema.Resource {
@@ -26,66 +26,95 @@ func dataSourceMorpheusNetworks() *schema.Resource {
 				Description: "The id of the Morpheus cloud to search for the network.",
 				Optional:    true,
 			},
-			"sort_ascending": {
-				Type:        schema.TypeBool,
-				Description: "Whether to sort the IDs in ascending order. Defaults to true",
-				Default:     true,
-				Optional:    true,
-			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
+			"networks": {
+				Type:        schema.TypeList,
+				Description: "The networks matching the filters, in the same order as the IDs",
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the network",
+							Computed:    true,
+						},
 						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name"}, false),
+							Type:        schema.TypeString,
+							Description: "The name of the network",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the network",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the network",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "The display name of the network",
+							Computed:    true,
 						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the network",
+							Computed:    true,
+						},
+						"network_type": {
+							Type:        schema.TypeString,
+							Description: "The code of the network type",
+							Computed:    true,
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "The CIDR of the network",
+							Computed:    true,
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Description: "Whether the network is active",
+							Computed:    true,
+						},
+						"visibility": {
+							Type:        schema.TypeString,
+							Description: "The visibility of the network",
+							Computed:    true,
+						},
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud the network belongs to",
+							Computed:    true,
 						},
 					},
 				},
 			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order. Defaults to true",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(false, "name", "code", "labels", "type", "tenant", "active"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var names []string
 
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			filterPayload := filter.(map[string]interface{})
-
-			if filterPayload["name"].(string) == "name" {
-				for _, item := range filterPayload["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -95,7 +124,6 @@ func dataSourceMorpheusNetworksRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	params := make(map[string]string)
-	params["max"] = "250"
 	params["sort"] = "id"
 	params["direction"] = sortOrder
 
@@ -104,36 +132,95 @@ func dataSourceMorpheusNetworksRead(ctx context.Context, d *schema.ResourceData,
 		params["zoneId"] = cloud_id_string
 	}
 
-	resp, err = client.ListNetworks(&morpheus.Request{
-		QueryParams: params,
-	})
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
 
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	networksIDs := []string{}
+	networkResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListNetworks(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
+		log.Printf("API RESPONSE: %s", resp)
 
-	var networksIDs []string
-
-	// store resource data
-	result := resp.Result.(*morpheus.ListNetworksResult)
-	networks := result.Networks
-	for _, network := range *networks {
-		if len(names) > 0 {
-			if regexCheck(names, network.Name) {
+		var networksPayload NetworksPayload
+		if err := json.Unmarshal(resp.Body, &networksPayload); err != nil {
+			return 0, 0, err
+		}
+		networks := networksPayload.Networks
+		matched := 0
+		for _, network := range networks {
+			attributes := map[string][]string{
+				"name":   {network.Name},
+				"code":   {network.Code},
+				"labels": network.Labels,
+				"type":   {network.Type.Code},
+				"tenant": tenantFilterValues(network.Owner.ID, network.Owner.Name),
+				"active": {strconv.FormatBool(network.Active)},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
 				networksIDs = append(networksIDs, strconv.Itoa(int(network.ID)))
+				networkResults = append(networkResults, map[string]interface{}{
+					"id":           network.ID,
+					"name":         network.Name,
+					"code":         network.Code,
+					"labels":       network.Labels,
+					"display_name": network.DisplayName,
+					"description":  network.Description,
+					"network_type": network.Type.Code,
+					"cidr":         network.Cidr,
+					"active":       network.Active,
+					"visibility":   network.Visibility,
+					"cloud_id":     network.Zone.ID,
+				})
+				matched++
 			}
-		} else {
-			networksIDs = append(networksIDs, strconv.Itoa(int(network.ID)))
 		}
+		return len(networks), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(networksIDs) > max {
+		networksIDs = networksIDs[:max]
+		networkResults = networkResults[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", networksIDs)
+	d.Set("networks", networkResults)
 	return diags
 }
+
+type NetworksPayload struct {
+	Networks []struct {
+		ID          int64    `json:"id"`
+		Name        string   `json:"name"`
+		Code        string   `json:"code"`
+		Labels      []string `json:"labels"`
+		DisplayName string   `json:"displayName"`
+		Description string   `json:"description"`
+		Type        struct {
+			Code string `json:"code"`
+		} `json:"type"`
+		Cidr       string `json:"cidr"`
+		Active     bool   `json:"active"`
+		Visibility string `json:"visibility"`
+		Zone       struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Owner struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"owner"`
+	} `json:"networks"`
+}
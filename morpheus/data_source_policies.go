@@ -2,13 +2,13 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusPolicies() *schema.Resource {
@@ -21,76 +21,80 @@ func dataSourceMorpheusPolicies() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"sort_ascending": {
-				Type:        schema.TypeBool,
-				Description: "Whether to sort the IDs in ascending order. Defaults to true",
-				Default:     true,
-				Optional:    true,
-			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
+			"policies": {
+				Type:        schema.TypeList,
+				Description: "The policies matching the filters, in the same order as the IDs",
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the policy",
+							Computed:    true,
+						},
 						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name, type)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name", "type"}, false),
+							Type:        schema.TypeString,
+							Description: "The name of the policy",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the policy",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the policy",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the policy",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the policy is enabled",
+							Computed:    true,
 						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+						"policy_type_code": {
+							Type:        schema.TypeString,
+							Description: "The code of the policy type",
+							Computed:    true,
+						},
+						"policy_type_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the policy type",
+							Computed:    true,
 						},
 					},
 				},
 			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order. Defaults to true",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(false, "name", "code", "labels", "type", "tenant", "enabled"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var policyTypes []string
-	var names []string
-
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			test := filter.(map[string]interface{})
-			if test["name"].(string) == "type" {
-				for _, item := range test["values"].(*schema.Set).List() {
-					policyTypes = append(policyTypes, item.(string))
-				}
-			}
-
-			if test["name"].(string) == "name" {
-				for _, item := range test["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(policyTypes) == 0 {
-		policyTypes = append(policyTypes, "$")
-	}
 
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -99,36 +103,90 @@ func dataSourceMorpheusPoliciesRead(ctx context.Context, d *schema.ResourceData,
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListPolicies(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "100",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+	}
 
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	policyIDs := []string{}
+	policyResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListPolicies(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
+		log.Printf("API RESPONSE: %s", resp)
 
-	var policyIDs []string
-
-	// store resource data
-	result := resp.Result.(*morpheus.ListPoliciesResult)
-	policies := result.Policies
-	for _, policy := range *policies {
-		if regexCheck(policyTypes, policy.PolicyType.Name) && regexCheck(names, policy.Name) {
-			policyIDs = append(policyIDs, strconv.Itoa(int(policy.ID)))
+		var policiesPayload PoliciesPayload
+		if err := json.Unmarshal(resp.Body, &policiesPayload); err != nil {
+			return 0, 0, err
+		}
+		policies := policiesPayload.Policies
+		matched := 0
+		for _, policy := range policies {
+			attributes := map[string][]string{
+				"name":    {policy.Name},
+				"code":    {policy.Code},
+				"labels":  policy.Labels,
+				"type":    {policy.PolicyType.Name, policy.PolicyType.Code},
+				"tenant":  tenantFilterValues(policy.Owner.ID, policy.Owner.Name),
+				"enabled": {strconv.FormatBool(policy.Enabled)},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				policyIDs = append(policyIDs, strconv.Itoa(int(policy.ID)))
+				policyResults = append(policyResults, map[string]interface{}{
+					"id":               policy.ID,
+					"name":             policy.Name,
+					"code":             policy.Code,
+					"labels":           policy.Labels,
+					"description":      policy.Description,
+					"enabled":          policy.Enabled,
+					"policy_type_code": policy.PolicyType.Code,
+					"policy_type_name": policy.PolicyType.Name,
+				})
+				matched++
+			}
 		}
+		return len(policies), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(policyIDs) > max {
+		policyIDs = policyIDs[:max]
+		policyResults = policyResults[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", policyIDs)
+	d.Set("policies", policyResults)
 	return diags
 }
+
+type PoliciesPayload struct {
+	Policies []struct {
+		ID          int64    `json:"id"`
+		Name        string   `json:"name"`
+		Code        string   `json:"code"`
+		Labels      []string `json:"labels"`
+		Description string   `json:"description"`
+		Enabled     bool     `json:"enabled"`
+		PolicyType  struct {
+			Code string `json:"code"`
+			Name string `json:"name"`
+		} `json:"policyType"`
+		Owner struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"owner"`
+	} `json:"policies"`
+}
@@ -64,7 +64,7 @@ func dataSourceMorpheusPolicy() *schema.Resource {
 }
 
 func dataSourceMorpheusPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
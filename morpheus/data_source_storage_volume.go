@@ -84,7 +84,7 @@ func dataSourceMorpheusStorageVolume() *schema.Resource {
 }
 
 func dataSourceMorpheusStorageVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -44,7 +44,7 @@ func dataSourceMorpheusStorageVolumeType() *schema.Resource {
 }
 
 func dataSourceMorpheusStorageTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
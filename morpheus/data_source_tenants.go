@@ -2,13 +2,13 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"strconv"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusTenants() *schema.Resource {
@@ -21,65 +21,90 @@ func dataSourceMorpheusTenants() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"sort_ascending": {
-				Type:        schema.TypeBool,
-				Description: "Whether to sort the IDs in ascending order. Defaults to true",
-				Default:     true,
-				Optional:    true,
-			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
+			"tenants": {
+				Type:        schema.TypeList,
+				Description: "The tenants matching the filters, in the same order as the IDs",
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant",
+							Computed:    true,
+						},
 						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name"}, false),
+							Type:        schema.TypeString,
+							Description: "The name of the tenant",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the tenant",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the tenant",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the tenant",
+							Computed:    true,
 						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+						"subdomain": {
+							Type:        schema.TypeString,
+							Description: "The subdomain of the tenant",
+							Computed:    true,
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Description: "Whether the tenant is active",
+							Computed:    true,
+						},
+						"account_number": {
+							Type:        schema.TypeString,
+							Description: "The account number of the tenant",
+							Computed:    true,
+						},
+						"customer_number": {
+							Type:        schema.TypeString,
+							Description: "The customer number of the tenant",
+							Computed:    true,
+						},
+						"role_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the base role of the tenant",
+							Computed:    true,
 						},
 					},
 				},
 			},
+			"max_results": maxResultsSchema(),
+			"sort_ascending": {
+				Type:        schema.TypeBool,
+				Description: "Whether to sort the IDs in ascending order. Defaults to true",
+				Default:     true,
+				Optional:    true,
+			},
+			"filter":      dataSourceFilterSchema(false, "name", "code", "labels", "active"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusTenantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var names []string
-
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			filterDetails := filter.(map[string]interface{})
-			if filterDetails["name"].(string) == "name" {
-				for _, item := range filterDetails["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
 
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -88,36 +113,89 @@ func dataSourceMorpheusTenantsRead(ctx context.Context, d *schema.ResourceData,
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListTenants(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "100",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+	}
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
 
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	tenantIDs := []string{}
+	tenantResults := []map[string]interface{}{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListTenants(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
+		log.Printf("API RESPONSE: %s", resp)
 
-	var tenantIDs []string
-
-	// store resource data
-	result := resp.Result.(*morpheus.ListTenantsResult)
-	tenants := result.Accounts
-	for _, tenant := range *tenants {
-		if regexCheck(names, tenant.Name) {
-			tenantIDs = append(tenantIDs, strconv.Itoa(int(tenant.ID)))
+		var tenantsPayload TenantsPayload
+		if err := json.Unmarshal(resp.Body, &tenantsPayload); err != nil {
+			return 0, 0, err
+		}
+		tenants := tenantsPayload.Accounts
+		matched := 0
+		for _, tenant := range tenants {
+			attributes := map[string][]string{
+				"name":   {tenant.Name},
+				"code":   {tenant.Code},
+				"labels": tenant.Labels,
+				"active": {strconv.FormatBool(tenant.Active)},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				tenantIDs = append(tenantIDs, strconv.Itoa(int(tenant.ID)))
+				tenantResults = append(tenantResults, map[string]interface{}{
+					"id":              tenant.ID,
+					"name":            tenant.Name,
+					"code":            tenant.Code,
+					"labels":          tenant.Labels,
+					"description":     tenant.Description,
+					"subdomain":       tenant.Subdomain,
+					"active":          tenant.Active,
+					"account_number":  tenant.AccountNumber,
+					"customer_number": tenant.CustomerNumber,
+					"role_id":         tenant.Role.ID,
+				})
+				matched++
+			}
 		}
+		return len(tenants), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(tenantIDs) > max {
+		tenantIDs = tenantIDs[:max]
+		tenantResults = tenantResults[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", tenantIDs)
+	d.Set("tenants", tenantResults)
 	return diags
 }
+
+type TenantsPayload struct {
+	Accounts []struct {
+		ID             int64    `json:"id"`
+		Name           string   `json:"name"`
+		Code           string   `json:"code"`
+		Labels         []string `json:"labels"`
+		Description    string   `json:"description"`
+		Subdomain      string   `json:"subdomain"`
+		Active         bool     `json:"active"`
+		AccountNumber  string   `json:"accountNumber"`
+		CustomerNumber string   `json:"customerNumber"`
+		Role           struct {
+			ID int64 `json:"id"`
+		} `json:"role"`
+	} `json:"accounts"`
+}
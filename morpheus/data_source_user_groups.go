@@ -8,7 +8,6 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusUserGroups() *schema.Resource {
@@ -21,65 +20,30 @@ func dataSourceMorpheusUserGroups() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"max_results": maxResultsSchema(),
 			"sort_ascending": {
 				Type:        schema.TypeBool,
 				Description: "Whether to sort the IDs in ascending order. Defaults to true",
 				Default:     true,
 				Optional:    true,
 			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name"}, false),
-						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-					},
-				},
-			},
+			"filter":      dataSourceFilterSchema(false, "name"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusUserGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	var resp *morpheus.Response
 	var err error
 	var sortOrder string
-	var names []string
 
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			filterDetails := filter.(map[string]interface{})
-			if filterDetails["name"].(string) == "name" {
-				for _, item := range filterDetails["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort environments in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -88,35 +52,50 @@ func dataSourceMorpheusUserGroupsRead(ctx context.Context, d *schema.ResourceDat
 		sortOrder = "desc"
 	}
 
-	resp, err = client.ListUserGroups(&morpheus.Request{
-		QueryParams: map[string]string{
-			"max":       "100",
-			"sort":      "id",
-			"direction": sortOrder,
-		},
-	})
+	params := map[string]string{
+		"sort":      "id",
+		"direction": sortOrder,
+	}
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
 
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-			return nil
-		} else {
+	userGroupIDs := []string{}
+
+	err = listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
+		resp, err := client.ListUserGroups(&morpheus.Request{
+			QueryParams: params,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("API 404: %s - %v", resp, err)
+				return 0, 0, nil
+			}
 			log.Printf("API FAILURE: %s - %v", resp, err)
-			return diag.FromErr(err)
+			return 0, 0, err
 		}
-	}
-	log.Printf("API RESPONSE: %s", resp)
+		log.Printf("API RESPONSE: %s", resp)
 
-	var userGroupIDs []string
-
-	// store resource data
-	result := resp.Result.(*morpheus.ListUserGroupsResult)
-	userGroups := result.UserGroups
-	for _, userGroup := range *userGroups {
-		if regexCheck(names, userGroup.Name) {
-			userGroupIDs = append(userGroupIDs, strconv.Itoa(int(userGroup.ID)))
+		result := resp.Result.(*morpheus.ListUserGroupsResult)
+		userGroups := result.UserGroups
+		matched := 0
+		for _, userGroup := range *userGroups {
+			attributes := map[string][]string{
+				"name": {userGroup.Name},
+			}
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				userGroupIDs = append(userGroupIDs, strconv.Itoa(int(userGroup.ID)))
+				matched++
+			}
 		}
+		return len(*userGroups), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(userGroupIDs) > max {
+		userGroupIDs = userGroupIDs[:max]
+	}
+
 	d.SetId("1")
 	d.Set("ids", userGroupIDs)
 	return diags
@@ -2,6 +2,7 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"strconv"
 
@@ -21,6 +22,52 @@ func dataSourceMorpheusVirtualImages() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"virtual_images": {
+				Type:        schema.TypeList,
+				Description: "The virtual images matching the filters, in the same order as the IDs",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the virtual image",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the virtual image",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the virtual image",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the virtual image",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"image_type": {
+							Type:        schema.TypeString,
+							Description: "The type of the virtual image, e.g. vmware or qcow2",
+							Computed:    true,
+						},
+						"os_type": {
+							Type:        schema.TypeString,
+							Description: "The code of the operating system of the virtual image",
+							Computed:    true,
+						},
+						"visibility": {
+							Type:        schema.TypeString,
+							Description: "The visibility of the virtual image",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"max_results": maxResultsSchema(),
 			"sort_ascending": {
 				Type:        schema.TypeBool,
 				Description: "Whether to sort the IDs in ascending order. Defaults to true",
@@ -34,68 +81,22 @@ func dataSourceMorpheusVirtualImages() *schema.Resource {
 				Default:      "User",
 				ValidateFunc: validation.StringInSlice([]string{"User", "System", "Synced"}, false),
 			},
-			"filter": {
-				Type:        schema.TypeSet,
-				Description: "Custom filter block as described below.",
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:         schema.TypeString,
-							Description:  "The name of the filter. Filter names are case-sensitive. Valid names are (name, type)",
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"name", "type"}, false),
-						},
-						"values": {
-							Type:        schema.TypeSet,
-							Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
-							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-					},
-				},
-			},
+			"filter":      dataSourceFilterSchema(true, "name", "code", "labels", "type", "tenant"),
+			"filter_mode": dataSourceFilterModeSchema(),
 		},
 	}
 }
 
 func dataSourceMorpheusVirtualImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	var sortOrder string
-	var imageTypes []string
-	var names []string
 
-	if len(d.Get("filter").(*schema.Set).List()) > 0 {
-		filters := d.Get("filter").(*schema.Set).List()
-		for _, filter := range filters {
-			filterData := filter.(map[string]interface{})
-			if filterData["name"].(string) == "type" {
-				for _, item := range filterData["values"].(*schema.Set).List() {
-					imageTypes = append(imageTypes, item.(string))
-				}
-			}
-
-			if filterData["name"].(string) == "name" {
-				for _, item := range filterData["values"].(*schema.Set).List() {
-					names = append(names, item.(string))
-				}
-			}
-		}
-	}
-
-	if len(imageTypes) == 0 {
-		imageTypes = append(imageTypes, "$")
-	}
-
-	if len(names) == 0 {
-		names = append(names, "$")
-	}
+	filters := expandDataSourceFilters(d)
+	filterMode := d.Get("filter_mode").(string)
 
 	// Sort virtual images in ascending or descending order
 	if d.Get("sort_ascending").(bool) {
@@ -104,70 +105,90 @@ func dataSourceMorpheusVirtualImagesRead(ctx context.Context, d *schema.Resource
 		sortOrder = "desc"
 	}
 
-	output := ListAllVirtualImages(client, 200, sortOrder, d.Get("source").(string))
-
-	var virtulImageIDs []string
-
-	// store resource data
-	for _, virtualImage := range output {
-		if regexCheck(imageTypes, virtualImage.ImageType) && regexCheck(names, virtualImage.Name) {
-			virtulImageIDs = append(virtulImageIDs, strconv.Itoa(int(virtualImage.ID)))
-		}
-	}
-
-	d.SetId("1")
-	d.Set("ids", virtulImageIDs)
-	return diags
-}
-
-func ListAllVirtualImages(client *morpheus.Client, max int, sortOrder string, source string) (images []morpheus.VirtualImage) {
-	// Fetch initial images
-	params := make(map[string]string)
-	params["max"] = strconv.Itoa(max)
-	params["sort"] = "id"
-	params["direction"] = sortOrder
-	params["filterType"] = source
-	resp, err := client.ListVirtualImages(&morpheus.Request{
-		QueryParams: params,
-	})
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("API 404: %s - %v", resp, err)
-		} else {
-			log.Printf("API FAILURE: %s - %v", resp, err)
-		}
+	params := map[string]string{
+		"sort":       "id",
+		"direction":  sortOrder,
+		"filterType": d.Get("source").(string),
 	}
-	log.Printf("API RESPONSE: %s", resp)
+	dataSourceFilterParams(filters, filterMode, map[string]string{"name": "name"}, params)
 
-	result := resp.Result.(*morpheus.ListVirtualImagesResult)
-	pollIterations := result.Meta.Total / int64(max)
-	// Add Page 1 virtual images
-	images = append(images, *result.VirtualImages...)
-	currentPage := 1
-	for currentPage < int(pollIterations) {
-		// Fetch initial images
-		params := make(map[string]string)
-		params["max"] = strconv.Itoa(max)
-		params["sort"] = "id"
-		params["direction"] = sortOrder
-		params["filterType"] = source
+	virtualImageIDs := []string{}
+	virtualImageResults := []map[string]interface{}{}
 
-		params["offset"] = strconv.Itoa(currentPage * max)
+	err := listAllPages(params, d.Get("max_results").(int), func(params map[string]string) (int, int, error) {
 		resp, err := client.ListVirtualImages(&morpheus.Request{
 			QueryParams: params,
 		})
 		if err != nil {
 			if resp != nil && resp.StatusCode == 404 {
 				log.Printf("API 404: %s - %v", resp, err)
-			} else {
-				log.Printf("API FAILURE: %s - %v", resp, err)
+				return 0, 0, nil
 			}
+			log.Printf("API FAILURE: %s - %v", resp, err)
+			return 0, 0, err
 		}
 		log.Printf("API RESPONSE: %s", resp)
 
-		result := resp.Result.(*morpheus.ListVirtualImagesResult)
-		images = append(images, *result.VirtualImages...)
-		currentPage++
+		var virtualImagesPayload VirtualImagesPayload
+		if err := json.Unmarshal(resp.Body, &virtualImagesPayload); err != nil {
+			return 0, 0, err
+		}
+		virtualImages := virtualImagesPayload.VirtualImages
+		matched := 0
+		for _, virtualImage := range virtualImages {
+			attributes := addTagFilterAttributes(map[string][]string{
+				"name":   {virtualImage.Name},
+				"code":   {virtualImage.Code},
+				"labels": virtualImage.Labels,
+				"type":   {virtualImage.ImageType},
+				"tenant": tenantFilterValues(virtualImage.Tenant.ID, virtualImage.Tenant.Name),
+			}, virtualImage.Tags)
+			if matchDataSourceFilters(filters, filterMode, attributes) {
+				virtualImageIDs = append(virtualImageIDs, strconv.Itoa(int(virtualImage.ID)))
+				virtualImageResults = append(virtualImageResults, map[string]interface{}{
+					"id":         virtualImage.ID,
+					"name":       virtualImage.Name,
+					"code":       virtualImage.Code,
+					"labels":     virtualImage.Labels,
+					"image_type": virtualImage.ImageType,
+					"os_type":    virtualImage.OsType.Code,
+					"visibility": virtualImage.Visibility,
+				})
+				matched++
+			}
+		}
+		return len(virtualImages), matched, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if max := d.Get("max_results").(int); max > 0 && len(virtualImageIDs) > max {
+		virtualImageIDs = virtualImageIDs[:max]
+		virtualImageResults = virtualImageResults[:max]
 	}
-	return images
+
+	d.SetId("1")
+	d.Set("ids", virtualImageIDs)
+	d.Set("virtual_images", virtualImageResults)
+	return diags
+}
+
+type VirtualImagesPayload struct {
+	VirtualImages []struct {
+		ID        int64    `json:"id"`
+		Name      string   `json:"name"`
+		Code      string   `json:"code"`
+		Labels    []string `json:"labels"`
+		ImageType string   `json:"imageType"`
+		OsType    struct {
+			Code string `json:"code"`
+		} `json:"osType"`
+		Visibility string `json:"visibility"`
+		Tenant     struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"tenant"`
+		Tags []dataSourceTag `json:"tags"`
+	} `json:"virtualImages"`
 }
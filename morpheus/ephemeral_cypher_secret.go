@@ -0,0 +1,97 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ephemeralCypherSecret reads a cypher secret without storing its value in
+// the plan or state.
+type ephemeralCypherSecret struct {
+	providerData interface{}
+}
+
+type ephemeralCypherSecretModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	TTL   types.Int64  `tfsdk:"ttl"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralCypherSecret{}
+
+func newEphemeralCypherSecret() ephemeral.EphemeralResource {
+	return &ephemeralCypherSecret{}
+}
+
+func (r *ephemeralCypherSecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cypher_secret"
+}
+
+func (r *ephemeralCypherSecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = ephemeralschema.Schema{
+		Description: "Provides a Morpheus cypher secret ephemeral resource, which reads a cypher secret without storing it in the plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]ephemeralschema.Attribute{
+			"key": ephemeralschema.StringAttribute{
+				Description: "The path of the cypher secret, excluding the secret prefix",
+				Required:    true,
+			},
+			"value": ephemeralschema.StringAttribute{
+				Description: "The cypher secret value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"ttl": ephemeralschema.Int64Attribute{
+				Description: "The time to live of the cypher secret",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralCypherSecret) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.providerData = req.ProviderData
+}
+
+func (r *ephemeralCypherSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralCypherSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meta, err := frameworkProviderMeta(r.providerData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cypher secret", err.Error())
+		return
+	}
+	client := meta.client
+
+	secretPath := fmt.Sprintf("secret/%s", data.Key.ValueString())
+	apiResp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%s", "/api/cypher", secretPath),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %v", apiResp, err)
+		resp.Diagnostics.AddError("Error reading cypher secret", fmt.Sprintf("error reading cypher secret %s: %s", data.Key.ValueString(), err))
+		return
+	}
+	log.Printf("API RESPONSE: %s", apiResp)
+
+	cypher := apiResp.Result.(*LocalGetCypherResult)
+	if cypher == nil {
+		resp.Diagnostics.AddError("Error reading cypher secret", "Cypher secret not found in response data.")
+		return
+	}
+	data.Value = types.StringValue(cypherSecretValue(cypher))
+	data.TTL = types.Int64Value(cypher.LeaseDuration)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,380 @@
+package morpheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeMorpheusServer is an in-process stand-in for the Morpheus REST API.
+// It keeps a generic in-memory store of objects keyed by their collection
+// path (e.g. /api/environments) so the standard create, get, list, update
+// and delete calls made by the SDK behave like they would against an
+// appliance. Every exchange is recorded, and a recorded session (cassette)
+// can be replayed in place of the in-memory store.
+type fakeMorpheusServer struct {
+	*httptest.Server
+
+	t  *testing.T
+	mu sync.Mutex
+
+	nextID      int64
+	collections map[string]*fakeCollection
+	stubs       map[string]fakeStub
+	handlers    map[string]fakeHandler
+	exchanges   []fakeExchange
+
+	// replay is the queue of recorded exchanges served instead of the
+	// in-memory store when the server was loaded from a cassette.
+	replay []fakeExchange
+}
+
+// fakeCollection holds the objects stored under a single collection path.
+// rootKey is the singular JSON key the API wraps each object in, e.g.
+// "environment" for /api/environments.
+type fakeCollection struct {
+	rootKey string
+	objects map[int64]map[string]interface{}
+}
+
+// fakeStub is a canned response registered for a method and path.
+type fakeStub struct {
+	status int
+	body   interface{}
+}
+
+// fakeHandler computes the response for a method and path from the
+// decoded request body. It is called with the server lock held so it can
+// read and change the in-memory store directly.
+type fakeHandler func(body interface{}) (int, interface{})
+
+// fakeExchange is a single recorded request and the response that was
+// returned for it.
+type fakeExchange struct {
+	Method       string      `json:"method"`
+	Path         string      `json:"path"`
+	Query        string      `json:"query,omitempty"`
+	RequestBody  interface{} `json:"request_body,omitempty"`
+	Status       int         `json:"status"`
+	ResponseBody interface{} `json:"response_body,omitempty"`
+}
+
+var fakeIDPath = regexp.MustCompile(`^(/api/.+)/(\d+)$`)
+
+// newFakeMorpheusServer starts a fake Morpheus API that is shut down when
+// the test completes.
+func newFakeMorpheusServer(t *testing.T) *fakeMorpheusServer {
+	t.Helper()
+	s := &fakeMorpheusServer{
+		t:           t,
+		collections: make(map[string]*fakeCollection),
+		stubs:       make(map[string]fakeStub),
+		handlers:    make(map[string]fakeHandler),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// newFakeMorpheusServerFromCassette starts a fake Morpheus API that replays
+// the exchanges recorded in the cassette file at path, in order.
+func newFakeMorpheusServerFromCassette(t *testing.T, path string) *fakeMorpheusServer {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading cassette %s: %s", path, err)
+	}
+	s := newFakeMorpheusServer(t)
+	if err := json.Unmarshal(data, &s.replay); err != nil {
+		t.Fatalf("error parsing cassette %s: %s", path, err)
+	}
+	return s
+}
+
+// stub registers a canned response for the given method and path, taking
+// precedence over the in-memory store.
+func (s *fakeMorpheusServer) stub(method, path string, status int, body interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stubs[method+" "+path] = fakeStub{status: status, body: body}
+}
+
+// handle registers a handler for the given method and path, for calls
+// whose response depends on the request, taking precedence over the
+// in-memory store.
+func (s *fakeMorpheusServer) handle(method, path string, handler fakeHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method+" "+path] = handler
+}
+
+// seed stores an object in a collection as if it had been created through
+// the API and returns its ID.
+func (s *fakeMorpheusServer) seed(path, rootKey string, object map[string]interface{}) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store(path, rootKey, object)
+}
+
+// requests returns the recorded exchanges matching the method and path.
+func (s *fakeMorpheusServer) requests(method, path string) []fakeExchange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matches []fakeExchange
+	for _, e := range s.exchanges {
+		if e.Method == method && e.Path == path {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
+
+// saveCassette writes every recorded exchange to path so the session can
+// later be replayed with newFakeMorpheusServerFromCassette.
+func (s *fakeMorpheusServer) saveCassette(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := json.MarshalIndent(s.exchanges, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// providerConfig returns a provider block pointed at the fake server.
+func (s *fakeMorpheusServer) providerConfig() string {
+	return fmt.Sprintf(`
+provider "morpheus" {
+  url          = %q
+  access_token = "acctest"
+}
+`, s.URL)
+}
+
+// checkDestroy verifies that no resource of the given type in the state is
+// still present in the fake server.
+func (s *fakeMorpheusServer) checkDestroy(resourceType string) func(*terraform.State) error {
+	return func(state *terraform.State) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			id := stringToInt64(rs.Primary.ID)
+			for path, c := range s.collections {
+				if _, ok := c.objects[id]; ok {
+					return fmt.Errorf("%s %s still exists in %s", resourceType, rs.Primary.ID, path)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func (s *fakeMorpheusServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var reqBody interface{}
+	if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &reqBody); err != nil {
+			reqBody = string(data)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, respBody := s.respond(r, reqBody)
+	s.exchanges = append(s.exchanges, fakeExchange{
+		Method:       r.Method,
+		Path:         r.URL.Path,
+		Query:        r.URL.RawQuery,
+		RequestBody:  reqBody,
+		Status:       status,
+		ResponseBody: respBody,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if respBody != nil {
+		json.NewEncoder(w).Encode(respBody)
+	}
+}
+
+func (s *fakeMorpheusServer) respond(r *http.Request, body interface{}) (int, interface{}) {
+	if stub, ok := s.stubs[r.Method+" "+r.URL.Path]; ok {
+		return stub.status, stub.body
+	}
+	if handler, ok := s.handlers[r.Method+" "+r.URL.Path]; ok {
+		return handler(body)
+	}
+
+	if s.replay != nil {
+		if len(s.replay) == 0 {
+			s.t.Errorf("unexpected request after cassette was exhausted: %s %s", r.Method, r.URL.Path)
+			return http.StatusInternalServerError, fakeError("cassette exhausted")
+		}
+		next := s.replay[0]
+		if next.Method != r.Method || next.Path != r.URL.Path {
+			s.t.Errorf("request %s %s does not match cassette entry %s %s", r.Method, r.URL.Path, next.Method, next.Path)
+			return http.StatusInternalServerError, fakeError("cassette mismatch")
+		}
+		s.replay = s.replay[1:]
+		return next.Status, next.ResponseBody
+	}
+
+	if r.URL.Path == "/oauth/token" {
+		return http.StatusOK, map[string]interface{}{
+			"access_token":  "acctest",
+			"refresh_token": "acctest",
+			"token_type":    "bearer",
+			"expires_in":    86400,
+			"scope":         "write",
+		}
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/api/") {
+		return http.StatusNotFound, fakeError("not found")
+	}
+
+	if m := fakeIDPath.FindStringSubmatch(r.URL.Path); m != nil {
+		return s.respondObject(r.Method, m[1], stringToInt64(m[2]), body)
+	}
+	return s.respondCollection(r, r.URL.Path, body)
+}
+
+func (s *fakeMorpheusServer) respondCollection(r *http.Request, path string, body interface{}) (int, interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		c := s.collections[path]
+		objects := []interface{}{}
+		if c != nil {
+			ids := make([]int64, 0, len(c.objects))
+			for id := range c.objects {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			name := r.URL.Query().Get("name")
+			for _, id := range ids {
+				object := c.objects[id]
+				if name != "" && object["name"] != name {
+					continue
+				}
+				objects = append(objects, object)
+			}
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+		total := len(objects)
+		if offset > total {
+			offset = total
+		}
+		page := objects[offset:]
+		if max > 0 && len(page) > max {
+			page = page[:max]
+		}
+		return http.StatusOK, map[string]interface{}{
+			"success":                             true,
+			path[strings.LastIndex(path, "/")+1:]: page,
+			"meta": map[string]interface{}{
+				"offset": offset,
+				"max":    max,
+				"size":   len(page),
+				"total":  total,
+			},
+		}
+	case http.MethodPost:
+		segment := path[strings.LastIndex(path, "/")+1:]
+		rootKey, object := fakeUnwrap(body, strings.TrimSuffix(segment, "s"))
+		if object == nil {
+			return http.StatusBadRequest, fakeError("request body must wrap a single object")
+		}
+		id := s.store(path, rootKey, object)
+		return http.StatusOK, map[string]interface{}{
+			"success": true,
+			rootKey:   s.collections[path].objects[id],
+		}
+	}
+	return http.StatusMethodNotAllowed, fakeError("method not allowed")
+}
+
+func (s *fakeMorpheusServer) respondObject(method, path string, id int64, body interface{}) (int, interface{}) {
+	c := s.collections[path]
+	if c == nil || c.objects[id] == nil {
+		return http.StatusNotFound, fakeError(fmt.Sprintf("%s %d not found", path, id))
+	}
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, map[string]interface{}{
+			"success": true,
+			c.rootKey: c.objects[id],
+		}
+	case http.MethodPut:
+		_, changes := fakeUnwrap(body, c.rootKey)
+		for k, v := range changes {
+			c.objects[id][k] = v
+		}
+		return http.StatusOK, map[string]interface{}{
+			"success": true,
+			c.rootKey: c.objects[id],
+		}
+	case http.MethodDelete:
+		delete(c.objects, id)
+		return http.StatusOK, map[string]interface{}{"success": true}
+	}
+	return http.StatusMethodNotAllowed, fakeError("method not allowed")
+}
+
+// store must be called with s.mu held.
+func (s *fakeMorpheusServer) store(path, rootKey string, object map[string]interface{}) int64 {
+	c := s.collections[path]
+	if c == nil {
+		c = &fakeCollection{rootKey: rootKey, objects: make(map[int64]map[string]interface{})}
+		s.collections[path] = c
+	}
+	s.nextID++
+	object["id"] = s.nextID
+	c.objects[s.nextID] = object
+	return s.nextID
+}
+
+// fakeUnwrap returns the root key and object of a request body such as
+// {"environment": {...}}, preferring rootKey when the body wraps more than
+// one object.
+func fakeUnwrap(body interface{}, rootKey string) (string, map[string]interface{}) {
+	payload, ok := body.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	if object, ok := payload[rootKey].(map[string]interface{}); ok {
+		return rootKey, object
+	}
+	keys := make([]string, 0, len(payload))
+	for k := range payload {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if object, ok := payload[k].(map[string]interface{}); ok {
+			return k, object
+		}
+	}
+	return "", nil
+}
+
+func fakeError(msg string) map[string]interface{} {
+	return map[string]interface{}{
+		"success": false,
+		"msg":     msg,
+	}
+}
@@ -0,0 +1,173 @@
+package morpheus
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceFilter is a filter block of a plural data source. The values
+// are regular expressions and the filter matches when any of them matches
+// any of the values of the named attribute.
+type dataSourceFilter struct {
+	name   string
+	values []string
+}
+
+// dataSourceTag is a tag as returned in the payload of objects that can be
+// filtered with a tag:<key> filter.
+type dataSourceTag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+var tagFilterName = regexp.MustCompile(`^tag:.+$`)
+
+// dataSourceFilterSchema returns the filter block shared by the plural data
+// sources. names are the filter names supported by the data source. When
+// tags is set, tag:<key> filters are accepted as well.
+func dataSourceFilterSchema(tags bool, names ...string) *schema.Schema {
+	description := fmt.Sprintf("The name of the filter. Filter names are case-sensitive. Valid names are (%s)", strings.Join(names, ", "))
+	validateName := validation.StringInSlice(names, false)
+	if tags {
+		description += " or tag:<key> to filter on the value of a tag"
+		validateName = validation.Any(validateName, validation.StringMatch(tagFilterName, "must be a valid filter name or tag:<key>"))
+	}
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Custom filter block as described below.",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Description:  description,
+					Required:     true,
+					ValidateFunc: validateName,
+				},
+				"values": {
+					Type:        schema.TypeSet,
+					Description: "The filter values. Filter values are case-sensitive. Filters values support the use of Golang regex and can be tested at https://regex101.com/",
+					Required:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceFilterModeSchema returns the filter_mode argument that controls
+// how the filter blocks of a plural data source are combined.
+func dataSourceFilterModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How filter blocks with different names are combined, either and (every filter must match) or or (any filter must match). Filter blocks with the same name are always combined with or. Defaults to and",
+		Optional:     true,
+		Default:      "and",
+		ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+	}
+}
+
+// expandDataSourceFilters returns the filter blocks of a data source with
+// the values of blocks sharing a name merged into a single filter.
+func expandDataSourceFilters(d *schema.ResourceData) []dataSourceFilter {
+	var filters []dataSourceFilter
+	index := make(map[string]int)
+	for _, filter := range d.Get("filter").(*schema.Set).List() {
+		filterPayload := filter.(map[string]interface{})
+		name := filterPayload["name"].(string)
+		i, ok := index[name]
+		if !ok {
+			i = len(filters)
+			index[name] = i
+			filters = append(filters, dataSourceFilter{name: name})
+		}
+		for _, item := range filterPayload["values"].(*schema.Set).List() {
+			filters[i].values = append(filters[i].values, item.(string))
+		}
+	}
+	return filters
+}
+
+// dataSourceFilterParams adds the filters that the API can apply itself to
+// the query params of a list request. params maps filter names to the query
+// param supported by the endpoint. Only filters matching a single exact
+// value (^value$) are sent, and only when every filter must match, since
+// the API would otherwise drop objects the other filters would match.
+func dataSourceFilterParams(filters []dataSourceFilter, mode string, supported map[string]string, params map[string]string) {
+	if mode == "or" {
+		return
+	}
+	for _, filter := range filters {
+		param, ok := supported[filter.name]
+		if !ok || len(filter.values) != 1 {
+			continue
+		}
+		if value, ok := exactMatchValue(filter.values[0]); ok {
+			params[param] = value
+		}
+	}
+}
+
+// exactMatchValue returns the literal value matched by a pattern of the
+// form ^value$, if the pattern has that form.
+func exactMatchValue(pattern string) (string, bool) {
+	if len(pattern) < 2 || !strings.HasPrefix(pattern, "^") || !strings.HasSuffix(pattern, "$") {
+		return "", false
+	}
+	value := pattern[1 : len(pattern)-1]
+	if value == "" || regexp.QuoteMeta(value) != value {
+		return "", false
+	}
+	return value, true
+}
+
+// matchDataSourceFilters reports whether an object matches the filters of
+// a data source. attributes maps each filter name to the values of the
+// object for that filter.
+func matchDataSourceFilters(filters []dataSourceFilter, mode string, attributes map[string][]string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		matched := false
+		for _, value := range attributes[filter.name] {
+			if regexCheck(filter.values, value) {
+				matched = true
+				break
+			}
+		}
+		if mode == "or" && matched {
+			return true
+		}
+		if mode != "or" && !matched {
+			return false
+		}
+	}
+	return mode != "or"
+}
+
+// addTagFilterAttributes adds the values of tags to the attributes of an
+// object under their tag:<key> filter names.
+func addTagFilterAttributes(attributes map[string][]string, tags []dataSourceTag) map[string][]string {
+	for _, tag := range tags {
+		key := "tag:" + tag.Name
+		attributes[key] = append(attributes[key], tag.Value)
+	}
+	return attributes
+}
+
+// tenantFilterValues returns the values a tenant filter matches against,
+// the ID and the name of the tenant.
+func tenantFilterValues(id int64, name string) []string {
+	if id == 0 {
+		return nil
+	}
+	return []string{strconv.FormatInt(id, 10), name}
+}
@@ -0,0 +1,69 @@
+package morpheus
+
+import (
+	"testing"
+)
+
+func TestMatchDataSourceFilters(t *testing.T) {
+	attributes := addTagFilterAttributes(map[string][]string{
+		"name":    {"tfacc-vmware"},
+		"labels":  {"prod", "east"},
+		"type":    {"vmware"},
+		"enabled": {"true"},
+	}, []dataSourceTag{{Name: "owner", Value: "platform"}})
+
+	cases := []struct {
+		name    string
+		filters []dataSourceFilter
+		mode    string
+		want    bool
+	}{
+		{"no filters", nil, "and", true},
+		{"single match", []dataSourceFilter{{name: "name", values: []string{"^tfacc"}}}, "and", true},
+		{"any value of a filter", []dataSourceFilter{{name: "type", values: []string{"amazon", "vmware"}}}, "and", true},
+		{"any label", []dataSourceFilter{{name: "labels", values: []string{"^east$"}}}, "and", true},
+		{"tag", []dataSourceFilter{{name: "tag:owner", values: []string{"platform"}}}, "and", true},
+		{"missing tag", []dataSourceFilter{{name: "tag:team", values: []string{".*"}}}, "and", false},
+		{"and with a mismatch", []dataSourceFilter{
+			{name: "name", values: []string{"^tfacc"}},
+			{name: "enabled", values: []string{"false"}},
+		}, "and", false},
+		{"or with a mismatch", []dataSourceFilter{
+			{name: "name", values: []string{"^tfacc"}},
+			{name: "enabled", values: []string{"false"}},
+		}, "or", true},
+		{"or without a match", []dataSourceFilter{
+			{name: "name", values: []string{"^other"}},
+			{name: "enabled", values: []string{"false"}},
+		}, "or", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchDataSourceFilters(tc.filters, tc.mode, attributes); got != tc.want {
+				t.Fatalf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDataSourceFilterParams(t *testing.T) {
+	filters := []dataSourceFilter{
+		{name: "name", values: []string{"^tfacc$"}},
+		{name: "type", values: []string{"^vm"}},
+		{name: "labels", values: []string{"^prod$", "^dev$"}},
+		{name: "code", values: []string{"^tfacc$"}},
+	}
+	supported := map[string]string{"name": "name", "type": "type", "labels": "labels"}
+
+	params := map[string]string{}
+	dataSourceFilterParams(filters, "and", supported, params)
+	if len(params) != 1 || params["name"] != "tfacc" {
+		t.Fatalf("expected only the exact name filter to be sent, got %v", params)
+	}
+
+	params = map[string]string{}
+	dataSourceFilterParams(filters, "or", supported, params)
+	if len(params) != 0 {
+		t.Fatalf("expected no filters to be sent when any filter may match, got %v", params)
+	}
+}
@@ -0,0 +1,139 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the parts of the provider that need
+// terraform-plugin-framework, such as ephemeral resources. It is muxed with
+// the SDK provider, which owns the provider configuration and the API
+// client, so every framework resource reads the client from the SDK
+// provider once it is configured.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// NewFrameworkProvider returns the framework provider to mux with the SDK
+// provider sdkProvider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "morpheus"
+}
+
+// Schema returns the schema of the SDK provider, as muxed providers must
+// all serve the same provider schema.
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	sdkResp, err := p.sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the provider schema", err.Error())
+		return
+	}
+	providerSchema, err := frameworkProviderSchema(sdkResp.Provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error converting the provider schema", err.Error())
+		return
+	}
+	resp.Schema = providerSchema
+}
+
+// Configure hands the SDK provider to the framework resources. The SDK
+// provider is configured by the mux server along with this provider.
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.EphemeralResourceData = p.sdkProvider
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralCypherSecret,
+	}
+}
+
+// frameworkProviderSchema converts the provider schema served by the SDK
+// provider to a framework provider schema. The provider only has primitive
+// arguments, so nested blocks and collection types are not supported.
+func frameworkProviderSchema(sdkSchema *tfprotov5.Schema) (providerschema.Schema, error) {
+	attributes := make(map[string]providerschema.Attribute)
+	for _, a := range sdkSchema.Block.Attributes {
+		description, markdownDescription := a.Description, ""
+		if a.DescriptionKind == tfprotov5.StringKindMarkdown {
+			description, markdownDescription = "", a.Description
+		}
+		deprecationMessage := ""
+		if a.Deprecated {
+			deprecationMessage = fmt.Sprintf("%s is deprecated", a.Name)
+		}
+
+		switch {
+		case a.Type.Is(tftypes.String):
+			attributes[a.Name] = providerschema.StringAttribute{
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Sensitive:           a.Sensitive,
+			}
+		case a.Type.Is(tftypes.Number):
+			attributes[a.Name] = providerschema.NumberAttribute{
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Sensitive:           a.Sensitive,
+			}
+		case a.Type.Is(tftypes.Bool):
+			attributes[a.Name] = providerschema.BoolAttribute{
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Sensitive:           a.Sensitive,
+			}
+		default:
+			return providerschema.Schema{}, fmt.Errorf("provider argument %s has the unsupported type %s", a.Name, a.Type)
+		}
+	}
+	if len(sdkSchema.Block.BlockTypes) > 0 {
+		return providerschema.Schema{}, fmt.Errorf("provider blocks are not supported")
+	}
+	return providerschema.Schema{Attributes: attributes}, nil
+}
+
+// frameworkProviderMeta returns the meta of the configured SDK provider
+// handed to a framework resource by frameworkProvider.Configure.
+func frameworkProviderMeta(providerData interface{}) (*providerMeta, error) {
+	sdkProvider, ok := providerData.(*schema.Provider)
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider data %T", providerData)
+	}
+	meta, ok := sdkProvider.Meta().(*providerMeta)
+	if !ok || meta == nil {
+		return nil, fmt.Errorf("the provider has not been configured")
+	}
+	return meta, nil
+}
@@ -0,0 +1,120 @@
+package morpheus
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFrameworkProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	sdkProvider := Provider()
+
+	sdkResp, err := sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	fwResp, err := providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider))().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range fwResp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !reflect.DeepEqual(fwResp.Provider, sdkResp.Provider) {
+		t.Errorf("expected the framework provider schema to match the SDK provider schema")
+	}
+
+	// The mux server refuses servers with different provider schemas
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+		sdkProvider.GRPCProvider,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating mux server: %s", err)
+	}
+	muxResp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range muxResp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if _, ok := muxResp.EphemeralResourceSchemas["morpheus_cypher_secret"]; !ok {
+		t.Errorf("expected the mux server to serve the morpheus_cypher_secret ephemeral resource")
+	}
+}
+
+func TestEphemeralCypherSecret(t *testing.T) {
+	ctx := context.Background()
+	srv := newFakeMorpheusServer(t)
+	srv.stub("GET", "/api/cypher/secret/app1/token", http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"type":           "string",
+		"data":           "token123",
+		"lease_duration": 120,
+		"cypher":         map[string]interface{}{"id": 1, "itemKey": "secret/app1/token"},
+	})
+
+	sdkProvider := Provider()
+	diags := sdkProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"url":          srv.URL,
+		"access_token": "acctest",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	r := newEphemeralCypherSecret().(*ephemeralCypherSecret)
+	r.Configure(ctx, ephemeral.ConfigureRequest{ProviderData: sdkProvider}, &ephemeral.ConfigureResponse{})
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"key":   tftypes.NewValue(tftypes.String, "app1/token"),
+				"value": tftypes.NewValue(tftypes.String, nil),
+				"ttl":   tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	r.Open(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error opening ephemeral resource: %v", resp.Diagnostics)
+	}
+
+	var value types.String
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("value"), &value)...)
+	var ttl types.Int64
+	resp.Diagnostics.Append(resp.Result.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading result: %v", resp.Diagnostics)
+	}
+	if value.ValueString() != "token123" {
+		t.Errorf("expected value token123, got %s", value)
+	}
+	if ttl.ValueInt64() != 120 {
+		t.Errorf("expected ttl 120, got %s", ttl)
+	}
+}
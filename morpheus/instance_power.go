@@ -0,0 +1,133 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// instancePowerStates are the values of the power_state argument, which
+// match the statuses of an instance in that power state.
+var instancePowerStates = []string{"running", "stopped", "suspended"}
+
+// powerStateSchema returns the power_state argument shared by the instance
+// resources. It is computed from the status of the instance when it is not
+// set, so instances are only started, stopped or suspended when asked to.
+func powerStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "The power state of the instance (running, stopped, suspended). The instance is left in the power state Morpheus provisions it in when this is not set",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(instancePowerStates, false),
+	}
+}
+
+// setPowerStateFromStatus sets power_state from the status of an instance.
+// Statuses other than running, stopped and suspended, such as provisioning
+// or failed, do not describe a power state and leave it unchanged.
+func setPowerStateFromStatus(d *schema.ResourceData, status string) {
+	for _, state := range instancePowerStates {
+		if status == state {
+			d.Set("power_state", status)
+		}
+	}
+}
+
+// instancePowerAction returns the instance action that moves an instance
+// with the current status to the target power state, or an empty string
+// when the instance is already in that state. It returns an error when no
+// action reaches the target state, such as suspending a stopped instance,
+// or the status is not a power state, such as failed.
+func instancePowerAction(current, target string) (string, error) {
+	if current == target {
+		return "", nil
+	}
+	switch current {
+	case "running":
+		switch target {
+		case "stopped":
+			return "stop", nil
+		case "suspended":
+			return "suspend", nil
+		}
+	case "stopped":
+		switch target {
+		case "running":
+			return "start", nil
+		}
+	case "suspended":
+		switch target {
+		case "running":
+			return "resume", nil
+		case "stopped":
+			return "stop", nil
+		}
+	}
+	return "", fmt.Errorf("cannot change the power state of an instance with status %s to %s", current, target)
+}
+
+// setInstancePowerState starts, stops, suspends or resumes an instance with
+// the current status and waits for it to reach the requested power state.
+// The status of the instance is looked up when current is empty.
+func setInstancePowerState(ctx context.Context, meta interface{}, id int64, current, state string, timeout time.Duration) error {
+	client := meta.(*providerMeta).client
+
+	if current == "" {
+		resp, err := client.GetInstance(id, &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		current = resp.Result.(*morpheus.GetInstanceResult).Instance.Status
+	}
+	action, err := instancePowerAction(current, state)
+	if err != nil {
+		return err
+	}
+	if action == "" {
+		return nil
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/instances/%d/%s", id, action),
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var pending []string
+	for _, status := range []string{"running", "stopped", "suspended", "starting", "stopping", "suspending", "resuming", "pending"} {
+		if status != state {
+			pending = append(pending, status)
+		}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{state},
+		Refresh: func() (interface{}, string, error) {
+			instanceDetails, err := client.GetInstance(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := instanceDetails.Result.(*morpheus.GetInstanceResult)
+			instance := result.Instance
+			return result, instance.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        pollDelay(meta, 10*time.Second),
+		PollInterval: pollInterval(meta, 10*time.Second),
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
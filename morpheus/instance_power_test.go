@@ -0,0 +1,72 @@
+package morpheus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSetInstancePowerState(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.stub("PUT", "/api/instances/1/stop", http.StatusOK, map[string]interface{}{"success": true})
+	srv.stub("GET", "/api/instances/1", http.StatusOK, map[string]interface{}{
+		"instance": map[string]interface{}{"id": 1, "status": "stopped"},
+	})
+
+	meta, diags := (&Config{
+		Url:          srv.URL,
+		AccessToken:  "acctest",
+		PollDelay:    time.Millisecond,
+		PollInterval: time.Millisecond,
+	}).meta()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	if err := setInstancePowerState(context.Background(), meta, 1, "running", "stopped", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(srv.requests("PUT", "/api/instances/1/stop")) != 1 {
+		t.Fatalf("expected the stop action to be called once")
+	}
+}
+
+func TestSetPowerStateFromStatus(t *testing.T) {
+	d := resourceMVMInstance().TestResourceData()
+	d.Set("power_state", "stopped")
+
+	setPowerStateFromStatus(d, "provisioning")
+	if got := d.Get("power_state").(string); got != "stopped" {
+		t.Fatalf("expected a transient status to leave power_state unchanged, got %s", got)
+	}
+
+	setPowerStateFromStatus(d, "running")
+	if got := d.Get("power_state").(string); got != "running" {
+		t.Fatalf("expected power_state to report the running instance, got %s", got)
+	}
+}
+
+func TestInstancePowerAction(t *testing.T) {
+	for _, tc := range []struct {
+		current, target, action string
+		err                     bool
+	}{
+		{current: "running", target: "running", action: ""},
+		{current: "running", target: "stopped", action: "stop"},
+		{current: "running", target: "suspended", action: "suspend"},
+		{current: "stopped", target: "running", action: "start"},
+		{current: "stopped", target: "suspended", err: true},
+		{current: "suspended", target: "running", action: "resume"},
+		{current: "suspended", target: "stopped", action: "stop"},
+		{current: "failed", target: "running", err: true},
+	} {
+		got, err := instancePowerAction(tc.current, tc.target)
+		if (err != nil) != tc.err {
+			t.Errorf("instancePowerAction(%q, %q) returned error %v, expected an error: %t", tc.current, tc.target, err, tc.err)
+		}
+		if got != tc.action {
+			t.Errorf("instancePowerAction(%q, %q) = %q, expected %q", tc.current, tc.target, got, tc.action)
+		}
+	}
+}
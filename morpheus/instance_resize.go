@@ -0,0 +1,236 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiInt64 returns the value of a numeric attribute of an API payload that
+// is decoded as an interface{}, which may hold a number or a string.
+func apiInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case json.Number:
+		i, _ := v.Int64()
+		return i
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// sameVolume reports whether two volumes in the form of the volumes argument
+// are the same volume. The root volume is matched on its root flag and the
+// other volumes on their name.
+func sameVolume(a, b map[string]interface{}) bool {
+	if a["root"] != b["root"] {
+		return false
+	}
+	return a["root"] == true || a["name"] == b["name"]
+}
+
+// resizeVolumes sets the id of the volumes of a resize payload. The root
+// volume keeps the ID of the root volume of the instance and the other
+// volumes keep the ID of the existing volume with the same name, so they are
+// kept or grown regardless of their position in the list. The others are sent
+// with an ID of -1 so they are added. Volumes of the instance missing from the
+// payload are removed.
+func resizeVolumes(payload []map[string]interface{}, existingVolumes Volumes) {
+	matched := make(map[int]bool)
+	for _, row := range payload {
+		row["id"] = -1
+		volume := map[string]interface{}{"root": row["rootVolume"] == true, "name": row["name"]}
+		for i, vol := range existingVolumes {
+			existing := map[string]interface{}{"root": vol.RootVolume == true, "name": vol.Name}
+			if !matched[i] && sameVolume(volume, existing) {
+				matched[i] = true
+				row["id"] = apiInt64(vol.ID)
+				break
+			}
+		}
+	}
+}
+
+// checkVolumeRenames returns an error when a volume of an instance is
+// renamed. Volumes are matched on their name when the instance is resized,
+// so a renamed volume would be removed along with its data and a blank
+// volume added in its place. A volume replaced by a volume with a name that
+// is already used by another volume is a removal, not a rename.
+func checkVolumeRenames(oldVolumes, newVolumes []interface{}) error {
+	oldNames := make(map[interface{}]bool)
+	for _, v := range oldVolumes {
+		oldNames[v.(map[string]interface{})["name"]] = true
+	}
+	newNames := make(map[interface{}]bool)
+	for _, v := range newVolumes {
+		newNames[v.(map[string]interface{})["name"]] = true
+	}
+	for i := 0; i < len(oldVolumes) && i < len(newVolumes); i++ {
+		oldVolume := oldVolumes[i].(map[string]interface{})
+		newVolume := newVolumes[i].(map[string]interface{})
+		if oldVolume["root"] == true || sameVolume(oldVolume, newVolume) {
+			continue
+		}
+		if !newNames[oldVolume["name"]] && !oldNames[newVolume["name"]] {
+			return fmt.Errorf("volume %v cannot be renamed to %v, renaming a volume removes it with its data and adds a blank volume", oldVolume["name"], newVolume["name"])
+		}
+	}
+	return nil
+}
+
+// volumeRenamesCustomizeDiff rejects renaming the volumes of an existing
+// instance, see checkVolumeRenames.
+func volumeRenamesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("volumes") {
+		return nil
+	}
+	oldVolumes, newVolumes := d.GetChange("volumes")
+	return checkVolumeRenames(oldVolumes.([]interface{}), newVolumes.([]interface{}))
+}
+
+// networkInterfaceKey returns the network of an existing network interface
+// in the format sent in the network id of a resize payload.
+func networkInterfaceKey(nic morpheus.NetworkInterface) string {
+	if nic.Network.Group == true {
+		return fmt.Sprintf("networkGroup-%d", int(nic.Network.ID))
+	}
+	return fmt.Sprintf("network-%d", int(nic.Network.ID))
+}
+
+// resizeNetworkInterfaces sets the id of the network interfaces of a resize
+// payload. Interfaces keep the ID of the existing interface of the instance
+// on the same network, the others are sent without an ID so they are added.
+// Interfaces of the instance missing from the payload are removed, callers
+// leave the interfaces out of the payload when none are configured.
+func resizeNetworkInterfaces(payload []map[string]interface{}, existingInterfaces []morpheus.NetworkInterface) {
+	matched := make(map[int]bool)
+	for _, row := range payload {
+		delete(row, "id")
+		network, _ := row["network"].(map[string]interface{})
+		if network == nil {
+			continue
+		}
+		for i, nic := range existingInterfaces {
+			if !matched[i] && networkInterfaceKey(nic) == network["id"] {
+				matched[i] = true
+				row["id"] = apiInt64(nic.ID)
+				break
+			}
+		}
+	}
+}
+
+// orderVolumes orders the volumes read from the API like the configured
+// volumes so a read does not reorder the list. The volumes are matched like
+// resizeVolumes matches them, volumes missing from the configuration are
+// appended.
+func orderVolumes(volumes []map[string]interface{}, configured []interface{}) []map[string]interface{} {
+	var ordered []map[string]interface{}
+	matched := make(map[int]bool)
+	for _, c := range configured {
+		item, _ := c.(map[string]interface{})
+		if item == nil {
+			continue
+		}
+		for i, row := range volumes {
+			if !matched[i] && sameVolume(row, item) {
+				matched[i] = true
+				ordered = append(ordered, row)
+				break
+			}
+		}
+	}
+	for i, row := range volumes {
+		if !matched[i] {
+			ordered = append(ordered, row)
+		}
+	}
+	return ordered
+}
+
+// orderNetworkInterfaces orders the network interfaces read from the API
+// like the configured interfaces so a read does not reorder the list. The
+// interfaces are matched on their network, interfaces missing from the
+// configuration are appended.
+func orderNetworkInterfaces(interfaces []map[string]interface{}, configured []interface{}) []map[string]interface{} {
+	var ordered []map[string]interface{}
+	matched := make(map[int]bool)
+	for _, c := range configured {
+		item, _ := c.(map[string]interface{})
+		if item == nil {
+			continue
+		}
+		for i, row := range interfaces {
+			if !matched[i] && row["network_id"] == item["network_id"] && row["network_group"] == item["network_group"] {
+				matched[i] = true
+				ordered = append(ordered, row)
+				break
+			}
+		}
+	}
+	for i, row := range interfaces {
+		if !matched[i] {
+			ordered = append(ordered, row)
+		}
+	}
+	return ordered
+}
+
+// resizeInstance resizes an instance and waits for the resize to complete.
+// Resizes that cannot be applied while the instance is running, such as a
+// plan change without hot add support, stop the instance. It is started
+// again afterwards when power_state is running. Changes to power_state are
+// left to the caller.
+func resizeInstance(ctx context.Context, d *schema.ResourceData, meta interface{}, id int64, payload map[string]interface{}) error {
+	client := meta.(*providerMeta).client
+
+	resp, err := client.ResizeInstance(id, &morpheus.Request{Body: payload})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"resizing", "pending", "stopping", "starting"},
+		Target:  []string{"running", "stopped", "suspended"},
+		Refresh: func() (interface{}, string, error) {
+			instanceDetails, err := client.GetInstance(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := instanceDetails.Result.(*morpheus.GetInstanceResult)
+			instance := result.Instance
+			return result, instance.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		MinTimeout:   1 * time.Minute,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := result.(*morpheus.GetInstanceResult).Instance.Status
+	if status == "stopped" && !d.HasChange("power_state") && d.Get("power_state").(string) == "running" {
+		log.Printf("[DEBUG] Instance %d was stopped to be resized, starting it again", id)
+		return setInstancePowerState(ctx, meta, id, status, "running", d.Timeout(schema.TimeoutUpdate))
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package morpheus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+)
+
+func TestResizeVolumes(t *testing.T) {
+	existingVolumes := Volumes{
+		{ID: float64(11), Name: "root", RootVolume: true},
+		{ID: float64(12), Name: "data", RootVolume: false},
+		{ID: float64(13), Name: "logs", RootVolume: false},
+	}
+
+	// Removing the first of two data volumes keeps the ID of the second
+	payload := parseStorageVolumes([]interface{}{
+		map[string]interface{}{"root": true, "name": "root", "size": 40},
+		map[string]interface{}{"root": false, "name": "logs", "size": 20},
+		map[string]interface{}{"root": false, "name": "cache", "size": 10},
+	})
+	resizeVolumes(payload, existingVolumes)
+
+	for i, want := range []interface{}{int64(11), int64(13), -1} {
+		if got := payload[i]["id"]; got != want {
+			t.Errorf("volume %d: expected id %v, got %v", i, want, got)
+		}
+	}
+}
+
+func TestCheckVolumeRenames(t *testing.T) {
+	volumes := func(names ...string) []interface{} {
+		var list []interface{}
+		for i, name := range names {
+			list = append(list, map[string]interface{}{"root": i == 0, "name": name})
+		}
+		return list
+	}
+	for _, tc := range []struct {
+		name     string
+		old, new []interface{}
+		err      bool
+	}{
+		{name: "unchanged", old: volumes("root", "data"), new: volumes("root", "data")},
+		{name: "root renamed", old: volumes("root", "data"), new: volumes("boot", "data")},
+		{name: "first removed", old: volumes("root", "data", "logs"), new: volumes("root", "logs")},
+		{name: "added", old: volumes("root", "data"), new: volumes("root", "data", "logs")},
+		{name: "renamed", old: volumes("root", "data"), new: volumes("root", "logs"), err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkVolumeRenames(tc.old, tc.new)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected an error: %t, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestResizeNetworkInterfaces(t *testing.T) {
+	existingInterfaces := make([]morpheus.NetworkInterface, 2)
+	existingInterfaces[0].ID = 21
+	existingInterfaces[0].Network.ID = 1
+	existingInterfaces[1].ID = 22
+	existingInterfaces[1].Network.ID = 2
+
+	// Removing the first interface keeps the ID of the second
+	payload := parseNetworkInterfaces([]interface{}{
+		map[string]interface{}{"network_id": 2, "network_group": false},
+		map[string]interface{}{"network_id": 3, "network_group": false},
+	})
+	resizeNetworkInterfaces(payload, existingInterfaces)
+
+	if got := payload[0]["id"]; got != int64(22) {
+		t.Errorf("expected the interface on network 2 to keep id 22, got %v", got)
+	}
+	if _, ok := payload[1]["id"]; ok {
+		t.Errorf("expected the interface on network 3 to be added without an id, got %v", payload[1]["id"])
+	}
+}
+
+func TestOrderVolumes(t *testing.T) {
+	volumes := []map[string]interface{}{
+		{"root": true, "name": "root"},
+		{"root": false, "name": "data"},
+		{"root": false, "name": "logs"},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"root": true, "name": "root"},
+		map[string]interface{}{"root": false, "name": "logs"},
+	}
+
+	ordered := orderVolumes(volumes, configured)
+
+	for i, want := range []string{"root", "logs", "data"} {
+		if got := ordered[i]["name"]; got != want {
+			t.Errorf("volume %d: expected %s, got %v", i, want, got)
+		}
+	}
+}
+
+func TestOrderNetworkInterfaces(t *testing.T) {
+	interfaces := []map[string]interface{}{
+		{"network_id": 1, "network_group": false},
+		{"network_id": 2, "network_group": false},
+		{"network_id": 3, "network_group": false},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"network_id": 2, "network_group": false},
+		map[string]interface{}{"network_id": 1, "network_group": false},
+	}
+
+	ordered := orderNetworkInterfaces(interfaces, configured)
+
+	for i, want := range []int{2, 1, 3} {
+		if got := ordered[i]["network_id"]; got != want {
+			t.Errorf("interface %d: expected network %d, got %v", i, want, got)
+		}
+	}
+}
+
+func TestResizeInstance(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.stub("PUT", "/api/instances/1/resize", http.StatusOK, map[string]interface{}{
+		"instance": map[string]interface{}{"id": 1, "status": "resizing"},
+	})
+	srv.stub("GET", "/api/instances/1", http.StatusOK, map[string]interface{}{
+		"instance": map[string]interface{}{"id": 1, "status": "running"},
+	})
+
+	meta, diags := (&Config{
+		Url:          srv.URL,
+		AccessToken:  "acctest",
+		PollDelay:    time.Millisecond,
+		PollInterval: time.Millisecond,
+	}).meta()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	d := resourceVsphereInstance().TestResourceData()
+	d.Set("power_state", "running")
+
+	payload := map[string]interface{}{
+		"instance": map[string]interface{}{
+			"plan": map[string]interface{}{"id": 2},
+		},
+	}
+	if err := resizeInstance(context.Background(), d, meta, 1, payload); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(srv.requests("PUT", "/api/instances/1/resize")) != 1 {
+		t.Fatalf("expected the instance to be resized once")
+	}
+	if len(srv.requests("PUT", "/api/instances/1/start")) != 0 {
+		t.Fatalf("expected a running instance not to be started again")
+	}
+}
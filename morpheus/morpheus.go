@@ -3,13 +3,3 @@
 // license that can be found in the LICENSE file.
 
 package morpheus
-
-import (
-	"os"
-)
-
-// global stuff here
-
-var (
-	USE_FORCE = (os.Getenv("USE_FORCE") == "true")
-)
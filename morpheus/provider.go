@@ -2,9 +2,12 @@ package morpheus
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func Provider() *schema.Provider {
@@ -23,7 +26,31 @@ func Provider() *schema.Provider {
 				Sensitive:     true,
 				Description:   "Access Token of Morpheus user. This can be used instead of authenticating with Username and Password.",
 				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_TOKEN", nil),
-				ConflictsWith: []string{"username", "password", "tenant_subdomain"},
+				ConflictsWith: []string{"username", "password", "tenant_subdomain", "access_token_file"},
+			},
+
+			"access_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a file holding the access token of the Morpheus user. The file is read again whenever the token is refreshed, so a token rotated by another process is picked up during a run",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_TOKEN_FILE", nil),
+				ConflictsWith: []string{"access_token", "refresh_token", "username", "password", "tenant_subdomain"},
+			},
+
+			"refresh_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Refresh token of the Morpheus user, used to obtain a new access token before the current one expires",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_REFRESH_TOKEN", nil),
+				ConflictsWith: []string{"access_token_file"},
+			},
+
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The OAuth client ID used to request and refresh access tokens",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_API_CLIENT_ID", "morph-api"),
 			},
 
 			"tenant_subdomain": {
@@ -31,7 +58,7 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Description:   "The tenant subdomain used for authentication",
 				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_TENANT", nil),
-				ConflictsWith: []string{"access_token"},
+				ConflictsWith: []string{"access_token", "access_token_file"},
 			},
 
 			"username": {
@@ -39,7 +66,7 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Description:   "Username of Morpheus user for authentication",
 				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_USERNAME", nil),
-				ConflictsWith: []string{"access_token"},
+				ConflictsWith: []string{"access_token", "access_token_file"},
 			},
 
 			"password": {
@@ -48,7 +75,118 @@ func Provider() *schema.Provider {
 				Sensitive:     true,
 				Description:   "Password of Morpheus user for authentication",
 				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_PASSWORD", nil),
-				ConflictsWith: []string{"access_token"},
+				ConflictsWith: []string{"access_token", "access_token_file"},
+			},
+
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip verification of the TLS certificate presented by the Morpheus Data Appliance",
+				DefaultFunc: schema.EnvDefaultFunc("MORPHEUS_API_INSECURE", false),
+			},
+
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a PEM encoded CA bundle used to verify the TLS certificate of the Morpheus Data Appliance",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "PEM encoded CA bundle used to verify the TLS certificate of the Morpheus Data Appliance",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a PEM encoded client certificate used for TLS client authentication",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CLIENT_CERT_FILE", nil),
+				ConflictsWith: []string{"client_cert_pem"},
+			},
+
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "PEM encoded client certificate used for TLS client authentication",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CLIENT_CERT_PEM", nil),
+				ConflictsWith: []string{"client_cert_file"},
+			},
+
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to the PEM encoded private key of the client certificate",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CLIENT_KEY_FILE", nil),
+				ConflictsWith: []string{"client_key_pem"},
+			},
+
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "PEM encoded private key of the client certificate",
+				DefaultFunc:   schema.EnvDefaultFunc("MORPHEUS_API_CLIENT_KEY_PEM", nil),
+				ConflictsWith: []string{"client_key_file"},
+			},
+
+			"poll_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How often resources poll Morpheus while waiting for an operation to complete, e.g. 30s or 1m. Defaults to the interval of each resource",
+				DefaultFunc:      schema.EnvDefaultFunc("MORPHEUS_API_POLL_INTERVAL", nil),
+				ValidateDiagFunc: validateDurationDiagFunc,
+			},
+
+			"poll_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How long resources wait before polling Morpheus for the first time after starting an operation, e.g. 30s or 1m. Defaults to the delay of each resource",
+				DefaultFunc:      schema.EnvDefaultFunc("MORPHEUS_API_POLL_DELAY", nil),
+				ValidateDiagFunc: validateDurationDiagFunc,
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of times a request is retried when Morpheus responds with 429 or 503, or when a GET, HEAD, PUT or DELETE request cannot reach Morpheus or receives a 502 or 504",
+				DefaultFunc:  schema.EnvDefaultFunc("MORPHEUS_API_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"retry_wait_min": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The minimum time to wait before retrying a request, e.g. 1s. Retry-After headers sent by Morpheus take precedence",
+				DefaultFunc:      schema.EnvDefaultFunc("MORPHEUS_API_RETRY_WAIT_MIN", "1s"),
+				ValidateDiagFunc: validateDurationDiagFunc,
+			},
+
+			"retry_wait_max": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The maximum time to wait before retrying a request, e.g. 30s",
+				DefaultFunc:      schema.EnvDefaultFunc("MORPHEUS_API_RETRY_WAIT_MAX", "30s"),
+				ValidateDiagFunc: validateDurationDiagFunc,
+			},
+
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  "The maximum number of requests per second sent to Morpheus across all resources. Defaults to 0 which does not limit requests",
+				DefaultFunc:  schema.EnvDefaultFunc("MORPHEUS_API_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to force the deletion of every instance and cluster that does not set its own force_delete argument. The USE_FORCE environment variable is still honored for backwards compatibility",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"MORPHEUS_FORCE_DELETE", "USE_FORCE"}, false),
 			},
 		},
 
@@ -59,6 +197,7 @@ func Provider() *schema.Provider {
 			"morpheus_ansible_tower_integration":             resourceAnsibleTowerIntegration(),
 			"morpheus_ansible_tower_task":                    resourceAnsibleTowerTask(),
 			"morpheus_api_option_list":                       resourceApiOptionList(),
+			"morpheus_app":                                   resourceApp(),
 			"morpheus_app_blueprint_catalog_item":            resourceAppBlueprintCatalogItem(),
 			"morpheus_appliance_setting":                     resourceApplianceSetting(),
 			"morpheus_arm_app_blueprint":                     resourceArmAppBlueprint(),
@@ -67,6 +206,7 @@ func Provider() *schema.Provider {
 			"morpheus_aws_instance":                          resourceAwsInstance(),
 			"morpheus_azure_cloud":                           resourceAzureCloud(),
 			"morpheus_backup_creation_policy":                resourceBackupCreationPolicy(),
+			"morpheus_backup_job":                            resourceBackupJob(),
 			"morpheus_backup_setting":                        resourceBackupSetting(),
 			"morpheus_boot_script":                           resourceBootScript(),
 			"morpheus_budget_policy":                         resourceBudgetPolicy(),
@@ -81,8 +221,11 @@ func Provider() *schema.Provider {
 			"morpheus_contact":                               resourceContact(),
 			"morpheus_credential":                            resourceCredential(),
 			"morpheus_cypher_access_policy":                  resourceCypherAccessPolicy(),
+			"morpheus_cypher_key":                            resourceCypherKey(),
+			"morpheus_cypher_password":                       resourceCypherPassword(),
 			"morpheus_cypher_secret":                         resourceCypherSecret(),
 			"morpheus_cypher_tfvars":                         resourceCypherTFVars(),
+			"morpheus_cypher_uuid":                           resourceCypherUUID(),
 			"morpheus_delayed_delete_policy":                 resourceDelayedDeletePolicy(),
 			"morpheus_delete_approval_policy":                resourceDeleteApprovalPolicy(),
 			"morpheus_docker_registry_integration":           resourceDockerRegistryIntegration(),
@@ -90,6 +233,7 @@ func Provider() *schema.Provider {
 			"morpheus_environment":                           resourceEnvironment(),
 			"morpheus_execute_schedule":                      resourceExecuteSchedule(),
 			"morpheus_file_template":                         resourceFileTemplate(),
+			"morpheus_file_share":                            resourceFileShare(),
 			"morpheus_form":                                  resourceForm(),
 			"morpheus_git_integration":                       resourceGitIntegration(),
 			"morpheus_groovy_script_task":                    resourceGroovyScriptTask(),
@@ -99,6 +243,8 @@ func Provider() *schema.Provider {
 			"morpheus_helm_spec_template":                    resourceHelmSpecTemplate(),
 			"morpheus_hidden_option_type":                    resourceHiddenOptionType(),
 			"morpheus_hostname_policy":                       resourceHostNamePolicy(),
+			"morpheus_instance":                              resourceInstance(),
+			"morpheus_instance_backup":                       resourceInstanceBackup(),
 			"morpheus_instance_catalog_item":                 resourceInstanceCatalogItem(),
 			"morpheus_instance_layout":                       resourceInstanceLayout(),
 			"morpheus_instance_name_policy":                  resourceInstanceNamePolicy(),
@@ -108,6 +254,11 @@ func Provider() *schema.Provider {
 			"morpheus_library_script_task":                   resourceLibraryScriptTask(),
 			"morpheus_library_template_task":                 resourceLibraryTemplateTask(),
 			"morpheus_license":                               resourceLicense(),
+			"morpheus_load_balancer":                         resourceLoadBalancer(),
+			"morpheus_load_balancer_monitor":                 resourceLoadBalancerMonitor(),
+			"morpheus_load_balancer_pool":                    resourceLoadBalancerPool(),
+			"morpheus_load_balancer_profile":                 resourceLoadBalancerProfile(),
+			"morpheus_load_balancer_virtual_server":          resourceLoadBalancerVirtualServer(),
 			"morpheus_key_pair":                              resourceKeyPair(),
 			"morpheus_kubernetes_app_blueprint":              resourceKubernetesAppBlueprint(),
 			"morpheus_kubernetes_spec_template":              resourceKubernetesSpecTemplate(),
@@ -122,12 +273,16 @@ func Provider() *schema.Provider {
 			"morpheus_motd_policy":                           resourceMotdPolicy(),
 			"morpheus_mvm_instance":                          resourceMVMInstance(),
 			"morpheus_nested_workflow_task":                  resourceNestedWorkflowTask(),
+			"morpheus_network":                               resourceNetwork(),
 			"morpheus_network_domain":                        resourceNetworkDomain(),
+			"morpheus_network_group":                         resourceNetworkGroup(),
 			"morpheus_network_quota_policy":                  resourceNetworkQuotaPolicy(),
+			"morpheus_network_subnet":                        resourceNetworkSubnet(),
 			"morpheus_node_type":                             resourceNodeType(),
 			"morpheus_number_option_type":                    resourceNumberOptionType(),
 			"morpheus_operational_workflow":                  resourceOperationalWorkflow(),
 			"morpheus_password_option_type":                  resourcePasswordOptionType(),
+			"morpheus_policy":                                resourcePolicy(),
 			"morpheus_power_schedule_policy":                 resourcePowerSchedulePolicy(),
 			"morpheus_powershell_script_task":                resourcePowerShellScriptTask(),
 			"morpheus_preseed_script":                        resourcePreseedScript(),
@@ -147,12 +302,15 @@ func Provider() *schema.Provider {
 			"morpheus_saml_identity_source":                  resourceSAMLIdentitySource(),
 			"morpheus_scale_threshold":                       resourceScaleThreshold(),
 			"morpheus_script_template":                       resourceScriptTemplate(),
+			"morpheus_security_group":                        resourceSecurityGroup(),
+			"morpheus_security_group_rule":                   resourceSecurityGroupRule(),
 			"morpheus_security_package":                      resourceSecurityPackage(),
 			"morpheus_select_list_option_type":               resourceSelectListOptionType(),
 			"morpheus_service_plan":                          resourceServicePlan(),
 			"morpheus_servicenow_integration":                resourceServiceNowIntegration(),
 			"morpheus_shell_script_task":                     resourceShellScriptTask(),
 			"morpheus_standard_cloud":                        resourceStandardCloud(),
+			"morpheus_storage_bucket":                        resourceStorageBucket(),
 			"morpheus_tag_policy":                            resourceTagPolicy(),
 			"morpheus_task_job":                              resourceTaskJob(),
 			"morpheus_tenant_role":                           resourceTenantRole(),
@@ -195,6 +353,7 @@ func Provider() *schema.Provider {
 			"morpheus_contact":                    dataSourceMorpheusContact(),
 			"morpheus_credential":                 dataSourceMorpheusCredential(),
 			"morpheus_cypher_secret":              dataSourceMorpheusCypherSecret(),
+			"morpheus_cypher_secrets":             dataSourceMorpheusCypherSecrets(),
 			"morpheus_domain":                     dataSourceMorpheusDomain(),
 			"morpheus_environment":                dataSourceMorpheusEnvironment(),
 			"morpheus_environments":               dataSourceMorpheusEnvironments(),
@@ -203,6 +362,8 @@ func Provider() *schema.Provider {
 			"morpheus_git_integration":            dataSourceMorpheusGitIntegration(),
 			"morpheus_group":                      dataSourceMorpheusGroup(),
 			"morpheus_groups":                     dataSourceMorpheusGroups(),
+			"morpheus_instance":                   dataSourceMorpheusInstance(),
+			"morpheus_instances":                  dataSourceMorpheusInstances(),
 			"morpheus_instance_layout":            dataSourceMorpheusInstanceLayout(),
 			"morpheus_instance_type":              dataSourceMorpheusInstanceType(),
 			"morpheus_integration":                dataSourceMorpheusIntegration(),
@@ -251,12 +412,47 @@ func Provider() *schema.Provider {
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Url:             d.Get("url").(string),
-		AccessToken:     d.Get("access_token").(string),
-		TenantSubdomain: d.Get("tenant_subdomain").(string),
-		Username:        d.Get("username").(string),
-		Password:        d.Get("password").(string),
-		//Insecure:                d.Get("insecure").(bool), //.(bool),
+		Url:               d.Get("url").(string),
+		AccessToken:       d.Get("access_token").(string),
+		AccessTokenFile:   d.Get("access_token_file").(string),
+		RefreshToken:      d.Get("refresh_token").(string),
+		ClientId:          d.Get("client_id").(string),
+		TenantSubdomain:   d.Get("tenant_subdomain").(string),
+		Username:          d.Get("username").(string),
+		Password:          d.Get("password").(string),
+		Insecure:          d.Get("insecure").(bool),
+		CACertFile:        d.Get("ca_cert_file").(string),
+		CACertPEM:         d.Get("ca_cert_pem").(string),
+		ClientCertFile:    d.Get("client_cert_file").(string),
+		ClientCertPEM:     d.Get("client_cert_pem").(string),
+		ClientKeyFile:     d.Get("client_key_file").(string),
+		ClientKeyPEM:      d.Get("client_key_pem").(string),
+		MaxRetries:        d.Get("max_retries").(int),
+		RequestsPerSecond: d.Get("requests_per_second").(float64),
+		ForceDelete:       d.Get("force_delete").(bool),
+	}
+	if v, ok := d.GetOk("poll_interval"); ok {
+		config.PollInterval, _ = time.ParseDuration(v.(string))
+	}
+	if v, ok := d.GetOk("poll_delay"); ok {
+		config.PollDelay, _ = time.ParseDuration(v.(string))
+	}
+	if v, ok := d.GetOk("retry_wait_min"); ok {
+		config.RetryWaitMin, _ = time.ParseDuration(v.(string))
+	}
+	if v, ok := d.GetOk("retry_wait_max"); ok {
+		config.RetryWaitMax, _ = time.ParseDuration(v.(string))
+	}
+	meta, diags := config.meta()
+	if diags.HasError() {
+		return nil, diags
+	}
+	return meta, diags
+}
+
+func validateDurationDiagFunc(i interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := time.ParseDuration(i.(string)); err != nil {
+		return diag.Errorf("%q is not a valid duration: %s", i.(string), err)
 	}
-	return config.Client()
+	return nil
 }
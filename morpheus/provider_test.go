@@ -0,0 +1,22 @@
+package morpheus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAccProviderFactories are used to instantiate a provider during
+// acceptance testing. Tests point the provider at a fakeMorpheusServer
+// through the provider block returned by its providerConfig method.
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"morpheus": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -138,7 +138,7 @@ func resourceActiveDirectoryIdentitySource() *schema.Resource {
 }
 
 func resourceActiveDirectoryIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -197,7 +197,7 @@ func resourceActiveDirectoryIdentitySourceCreate(ctx context.Context, d *schema.
 }
 
 func resourceActiveDirectoryIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -263,7 +263,7 @@ func resourceActiveDirectoryIdentitySourceRead(ctx context.Context, d *schema.Re
 }
 
 func resourceActiveDirectoryIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	identitySource := make(map[string]interface{})
@@ -321,7 +321,7 @@ func resourceActiveDirectoryIdentitySourceUpdate(ctx context.Context, d *schema.
 }
 
 func resourceActiveDirectoryIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
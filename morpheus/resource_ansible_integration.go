@@ -144,7 +144,7 @@ func resourceAnsibleIntegration() *schema.Resource {
 }
 
 func resourceAnsibleIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -197,7 +197,7 @@ func resourceAnsibleIntegrationCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAnsibleIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -252,7 +252,7 @@ func resourceAnsibleIntegrationRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -302,7 +302,7 @@ func resourceAnsibleIntegrationUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAnsibleIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
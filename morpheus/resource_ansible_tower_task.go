@@ -121,7 +121,7 @@ func resourceAnsibleTowerTask() *schema.Resource {
 }
 
 func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -181,7 +181,7 @@ func resourceAnsibleTowerTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -249,7 +249,7 @@ func resourceAnsibleTowerTaskRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -314,7 +314,7 @@ func resourceAnsibleTowerTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAnsibleTowerTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
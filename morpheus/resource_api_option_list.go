@@ -78,7 +78,7 @@ func resourceApiOptionList() *schema.Resource {
 }
 
 func resourceApiOptionListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -122,7 +122,7 @@ func resourceApiOptionListCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceApiOptionListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -174,7 +174,7 @@ func resourceApiOptionListRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -213,7 +213,7 @@ func resourceApiOptionListUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceApiOptionListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
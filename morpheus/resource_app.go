@@ -0,0 +1,776 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus app resource to deploy an app from an app blueprint",
+		CreateContext: resourceAppCreate,
+		ReadContext:   resourceAppRead,
+		UpdateContext: resourceAppUpdate,
+		DeleteContext: resourceAppDelete,
+		CustomizeDiff: appTierConfigCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the app",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the app",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the app",
+				Optional:    true,
+			},
+			"blueprint_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the app blueprint to deploy the app from",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group to deploy the app to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Description: "The code of the environment to deploy the app to",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"default_cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud to deploy the app to when the blueprint does not set one",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The labels associated with the app",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object merged into the configuration of the blueprint when the app is deployed, such as the terraform variables of a terraform app blueprint",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"tier": {
+				Type:        schema.TypeList,
+				Description: "The tiers of the blueprint to deploy. Every tier of the blueprint is deployed when no tier is set. Tiers can be added or removed after the app is deployed, changing the config of a deployed tier replaces the app",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the tier, as defined in the blueprint",
+							Required:    true,
+						},
+						"config": {
+							Type:             schema.TypeString,
+							Description:      "A JSON object merged into the configuration of every instance of the tier, used to override the blueprint settings of the tier. Changing the config of a deployed tier replaces the app",
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the app",
+				Computed:    true,
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Description: "Whether to force the deletion of the app, even if the removal of its instances fails. Defaults to the force_delete argument of the provider",
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Type:        schema.TypeBool,
+				Description: "Whether to preserve the volumes of the instances of the app when it is deleted",
+				Optional:    true,
+			},
+			"keep_backups": {
+				Type:        schema.TypeBool,
+				Description: "Whether to keep the backups of the instances of the app when it is deleted",
+				Optional:    true,
+			},
+			"release_ips": {
+				Type:        schema.TypeBool,
+				Description: "Whether to release the elastic and floating IP addresses of the instances of the app when it is deleted",
+				Optional:    true,
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Description: "The instances of the app",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the instance",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the instance",
+							Computed:    true,
+						},
+						"tier": {
+							Type:        schema.TypeString,
+							Description: "The name of the tier of the instance",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the instance",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	payload, err := getAppConfig(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only deploy the selected tiers, with their overrides
+	if len(d.Get("tier").([]interface{})) > 0 {
+		tiers, ok := payload["tiers"].(map[string]interface{})
+		if !ok {
+			return diag.Errorf("blueprint %d does not define any tiers", d.Get("blueprint_id").(int))
+		}
+		selectedTiers := make(map[string]interface{})
+		for _, tier := range d.Get("tier").([]interface{}) {
+			tierConfig := tier.(map[string]interface{})
+			name := tierConfig["name"].(string)
+			blueprintTier, ok := tiers[name].(map[string]interface{})
+			if !ok {
+				return diag.Errorf("tier %s is not defined in blueprint %d", name, d.Get("blueprint_id").(int))
+			}
+			if err := overrideAppTierInstances(blueprintTier, tierConfig["config"].(string)); err != nil {
+				return diag.Errorf("error parsing config of tier %s: %s", name, err)
+			}
+			selectedTiers[name] = blueprintTier
+		}
+		payload["tiers"] = selectedTiers
+	}
+
+	payload["blueprintId"] = d.Get("blueprint_id").(int)
+	payload["name"] = d.Get("name").(string)
+	payload["description"] = d.Get("description").(string)
+	payload["group"] = map[string]interface{}{
+		"id": d.Get("group_id").(int),
+	}
+	if d.Get("environment").(string) != "" {
+		payload["environment"] = d.Get("environment").(string)
+	}
+	if d.Get("default_cloud_id").(int) != 0 {
+		payload["defaultCloud"] = map[string]interface{}{
+			"id": d.Get("default_cloud_id").(int),
+		}
+	}
+	payload["labels"] = d.Get("labels").(*schema.Set).List()
+
+	req := &morpheus.Request{Body: payload}
+	resp, err := client.CreateApp(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var app AppPayload
+	if err := json.Unmarshal(resp.Body, &app); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(int64ToString(app.App.ID))
+
+	// Wait for every instance of the app to be provisioned
+	if err := waitForAppStatus(ctx, meta, app.App.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error creating app: %s", err)
+	}
+
+	resourceAppRead(ctx, d, meta)
+	return diags
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetApp(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var app AppPayload
+	if err := json.Unmarshal(resp.Body, &app); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(int64ToString(app.App.ID))
+	d.Set("name", app.App.Name)
+	d.Set("description", app.App.Description)
+	d.Set("group_id", app.App.Group.ID)
+	if app.App.Blueprint.ID != 0 {
+		d.Set("blueprint_id", app.App.Blueprint.ID)
+	}
+	d.Set("labels", app.App.Labels)
+	d.Set("status", app.App.Status)
+
+	var instances []map[string]interface{}
+	for _, tier := range app.App.AppTiers {
+		for _, appInstance := range tier.AppInstances {
+			instances = append(instances, map[string]interface{}{
+				"id":     appInstance.Instance.ID,
+				"name":   appInstance.Instance.Name,
+				"tier":   tier.Tier.Name,
+				"status": appInstance.Instance.Status,
+			})
+		}
+	}
+	d.Set("instances", instances)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	if d.HasChanges("name", "description", "labels") {
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"app": map[string]interface{}{
+					"name":        d.Get("name").(string),
+					"description": d.Get("description").(string),
+					"labels":      d.Get("labels").(*schema.Set).List(),
+				},
+			},
+		}
+		resp, err := client.UpdateApp(toInt64(id), req)
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	if d.HasChange("tier") {
+		appConfig, err := getAppConfig(client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		blueprintTiers, _ := appConfig["tiers"].(map[string]interface{})
+
+		// A change to the config of a deployed tier replaces the app, so
+		// only the tiers that were added or removed are handled here
+		oldTiers, newTiers := d.GetChange("tier")
+		oldNames := appTierNames(oldTiers.([]interface{}), blueprintTiers)
+		newNames := appTierNames(newTiers.([]interface{}), blueprintTiers)
+		removed := changedAppTiers(newNames, oldNames)
+		added := changedAppTiers(oldNames, newNames)
+
+		if len(removed) > 0 {
+			if err := removeAppTiers(ctx, d, meta, removed); err != nil {
+				return diag.Errorf("error removing tiers of app: %s", err)
+			}
+		}
+		if len(added) > 0 {
+			if err := addAppTiers(ctx, d, meta, blueprintTiers, added); err != nil {
+				return diag.Errorf("error adding tiers to app: %s", err)
+			}
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{
+		QueryParams: map[string]string{
+			"removeInstances": "on",
+		},
+	}
+	deleteQueryParams(d, meta, req.QueryParams)
+	resp, err := client.DeleteApp(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// Wait for the instances of the app to be torn down
+	stateConf := retry.StateChangeConf{
+		Delay:        pollDelay(meta, 1*time.Second),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: pollInterval(meta, 10*time.Second),
+		MinTimeout:   1 * time.Second,
+		Pending:      []string{"200"},
+		Target:       []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err = client.GetApp(toInt64(id), &morpheus.Request{})
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// getAppConfig returns the payload an app is deployed from, the
+// configuration of its blueprint merged with the app level overrides of
+// the config argument.
+func getAppConfig(client *morpheus.Client, d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := getAppBlueprintConfig(client, int64(d.Get("blueprint_id").(int)))
+	if err != nil {
+		return nil, err
+	}
+	if d.Get("config").(string) != "" {
+		var overrides map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("config").(string)), &overrides); err != nil {
+			return nil, fmt.Errorf("error parsing config: %s", err)
+		}
+		mergeAppConfig(config, overrides)
+	}
+	return config, nil
+}
+
+// getAppBlueprintConfig returns the configuration of an app blueprint, the
+// payload an app is deployed from.
+func getAppBlueprintConfig(client *morpheus.Client, id int64) (map[string]interface{}, error) {
+	resp, err := client.GetBlueprint(id, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var blueprint struct {
+		Blueprint struct {
+			Config map[string]interface{} `json:"config"`
+		} `json:"blueprint"`
+	}
+	if err := json.Unmarshal(resp.Body, &blueprint); err != nil {
+		return nil, err
+	}
+	if blueprint.Blueprint.Config == nil {
+		return make(map[string]interface{}), nil
+	}
+	return blueprint.Blueprint.Config, nil
+}
+
+// mergeAppConfig merges src into dst. Nested objects are merged key by key,
+// any other value of src replaces the value of dst.
+func mergeAppConfig(dst map[string]interface{}, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcOk := value.(map[string]interface{})
+		dstMap, dstOk := dst[key].(map[string]interface{})
+		if srcOk && dstOk {
+			mergeAppConfig(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// overrideAppTierInstances merges the config of a tier block into every
+// instance of the tier as defined in the blueprint.
+func overrideAppTierInstances(tier map[string]interface{}, config string) error {
+	if config == "" {
+		return nil
+	}
+	var override map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &override); err != nil {
+		return err
+	}
+	instances, _ := tier["instances"].([]interface{})
+	for _, instance := range instances {
+		if instanceConfig, ok := instance.(map[string]interface{}); ok {
+			mergeAppConfig(instanceConfig, override)
+		}
+	}
+	return nil
+}
+
+// appTierNames maps the names of the tiers deployed by the tier blocks to
+// their config. Every tier of the blueprint is deployed, without overrides,
+// when no tier block is set.
+func appTierNames(tiers []interface{}, blueprintTiers map[string]interface{}) map[string]string {
+	names := make(map[string]string)
+	if len(tiers) == 0 {
+		for name := range blueprintTiers {
+			names[name] = ""
+		}
+		return names
+	}
+	for _, tier := range tiers {
+		tierConfig := tier.(map[string]interface{})
+		names[tierConfig["name"].(string)] = tierConfig["config"].(string)
+	}
+	return names
+}
+
+// changedAppTiers returns the tiers of to, mapped to their config, that are
+// missing from from.
+func changedAppTiers(from map[string]string, to map[string]string) map[string]string {
+	changed := make(map[string]string)
+	for name, config := range to {
+		if _, ok := from[name]; !ok {
+			changed[name] = config
+		}
+	}
+	return changed
+}
+
+// appTierConfigCustomizeDiff replaces an app when the config of one of its
+// deployed tiers changes, as the instances of the tier would otherwise be
+// deleted and provisioned again by an in-place update.
+func appTierConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("tier") {
+		return nil
+	}
+	oldTiers, newTiers := d.GetChange("tier")
+	if appTierConfigChanged(oldTiers.([]interface{}), newTiers.([]interface{})) {
+		return d.ForceNew("tier")
+	}
+	return nil
+}
+
+// appTierConfigChanged reports whether a tier deployed by the tier blocks
+// from is kept by the tier blocks to with a different config. No tier block
+// deploys every tier of the blueprint without overrides.
+func appTierConfigChanged(from []interface{}, to []interface{}) bool {
+	fromTiers := appTierNames(from, nil)
+	toTiers := appTierNames(to, nil)
+	for name, config := range toTiers {
+		fromConfig, ok := fromTiers[name]
+		if !ok && len(from) > 0 {
+			// The tier is added to the app
+			continue
+		}
+		if !appTierConfigEqual(fromConfig, config) {
+			return true
+		}
+	}
+	if len(to) == 0 {
+		for _, config := range fromTiers {
+			if config != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// appTierConfigEqual reports whether two tier configs hold the same JSON.
+func appTierConfigEqual(a string, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	return suppressEquivalentJsonDiffs("", a, b, nil)
+}
+
+// waitForAppStatus waits for every instance of an app to be provisioned.
+func waitForAppStatus(ctx context.Context, meta interface{}, id int64, timeout time.Duration) error {
+	client := meta.(*providerMeta).client
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"provisioning", "pending", "starting", "resizing", "unknown"},
+		Target:  []string{"running", "warning", "failed", "cancelled", "denied"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetApp(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			var app AppPayload
+			if err := json.Unmarshal(resp.Body, &app); err != nil {
+				return "", "", err
+			}
+			return app, app.App.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   1 * time.Minute,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+	if status := result.(AppPayload).App.Status; status != "running" && status != "warning" {
+		return fmt.Errorf("app %d is %s", id, status)
+	}
+	return nil
+}
+
+// removeAppTiers removes the instances of the given tiers from an app,
+// deletes them with the delete options of the app and waits for them to be
+// gone.
+func removeAppTiers(ctx context.Context, d *schema.ResourceData, meta interface{}, tiers map[string]string) error {
+	client := meta.(*providerMeta).client
+	id := toInt64(d.Id())
+
+	resp, err := client.GetApp(id, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	var app AppPayload
+	if err := json.Unmarshal(resp.Body, &app); err != nil {
+		return err
+	}
+
+	var deleted []int64
+	for _, tier := range app.App.AppTiers {
+		if _, ok := tiers[tier.Tier.Name]; !ok {
+			continue
+		}
+		for _, appInstance := range tier.AppInstances {
+			resp, err := client.Execute(&morpheus.Request{
+				Method: "POST",
+				Path:   fmt.Sprintf("/api/apps/%d/remove-instance", id),
+				Body: map[string]interface{}{
+					"instanceId": appInstance.Instance.ID,
+				},
+			})
+			if err != nil {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+
+			req := &morpheus.Request{
+				QueryParams: map[string]string{},
+			}
+			deleteQueryParams(d, meta, req.QueryParams)
+			resp, err = client.DeleteInstance(appInstance.Instance.ID, req)
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+			deleted = append(deleted, appInstance.Instance.ID)
+		}
+	}
+
+	// Wait for the instances to be removed so that the instances of an
+	// added tier can reuse their names
+	for _, instanceID := range deleted {
+		if err := waitForInstanceDeleted(ctx, meta, instanceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForInstanceDeleted waits for a deleted instance to be removed.
+func waitForInstanceDeleted(ctx context.Context, meta interface{}, id int64, timeout time.Duration) error {
+	client := meta.(*providerMeta).client
+
+	stateConf := retry.StateChangeConf{
+		Delay:        pollDelay(meta, 1*time.Second),
+		Timeout:      timeout,
+		PollInterval: pollInterval(meta, 10*time.Second),
+		MinTimeout:   1 * time.Second,
+		Pending:      []string{"200"},
+		Target:       []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetInstance(id, &morpheus.Request{})
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// addAppTiers provisions the instances of the given tiers, as defined in
+// the blueprint tiers of the app config, and adds them to the app.
+func addAppTiers(ctx context.Context, d *schema.ResourceData, meta interface{}, blueprintTiers map[string]interface{}, tiers map[string]string) error {
+	client := meta.(*providerMeta).client
+	id := toInt64(d.Id())
+
+	for name, config := range tiers {
+		blueprintTier, ok := blueprintTiers[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("tier %s is not defined in blueprint %d", name, d.Get("blueprint_id").(int))
+		}
+		if err := overrideAppTierInstances(blueprintTier, config); err != nil {
+			return fmt.Errorf("error parsing config of tier %s: %s", name, err)
+		}
+		instances, _ := blueprintTier["instances"].([]interface{})
+		for _, instance := range instances {
+			payload, ok := instance.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			instancePayload, ok := payload["instance"].(map[string]interface{})
+			if !ok {
+				instancePayload = make(map[string]interface{})
+				payload["instance"] = instancePayload
+			}
+			instancePayload["site"] = map[string]interface{}{
+				"id": d.Get("group_id").(int),
+			}
+			if d.Get("environment").(string) != "" {
+				instancePayload["instanceContext"] = d.Get("environment").(string)
+			}
+			if _, ok := payload["zoneId"]; !ok && d.Get("default_cloud_id").(int) != 0 {
+				payload["zoneId"] = d.Get("default_cloud_id").(int)
+			}
+
+			resp, err := client.CreateInstance(&morpheus.Request{Body: payload})
+			if err != nil {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+			result := resp.Result.(*morpheus.CreateInstanceResult)
+			instanceID := result.Instance.ID
+
+			resp, err = client.Execute(&morpheus.Request{
+				Method: "POST",
+				Path:   fmt.Sprintf("/api/apps/%d/add-instance", id),
+				Body: map[string]interface{}{
+					"instanceId": instanceID,
+					"tierName":   name,
+				},
+			})
+			if err != nil {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return err
+			}
+			log.Printf("API RESPONSE: %s", resp)
+		}
+	}
+
+	return waitForAppStatus(ctx, meta, id, d.Timeout(schema.TimeoutUpdate))
+}
+
+type AppPayload struct {
+	App struct {
+		ID          int64    `json:"id"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Status      string   `json:"status"`
+		Labels      []string `json:"labels"`
+		Group       struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"group"`
+		Blueprint struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"blueprint"`
+		AppTiers []struct {
+			Tier struct {
+				ID   int64  `json:"id"`
+				Name string `json:"name"`
+			} `json:"tier"`
+			AppInstances []struct {
+				Instance struct {
+					ID     int64  `json:"id"`
+					Name   string `json:"name"`
+					Status string `json:"status"`
+				} `json:"instance"`
+			} `json:"appInstances"`
+		} `json:"appTiers"`
+	} `json:"app"`
+}
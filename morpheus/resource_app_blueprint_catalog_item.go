@@ -137,7 +137,7 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 }
 
 func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -237,7 +237,7 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -303,7 +303,7 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	catalogItem := make(map[string]interface{})
@@ -400,7 +400,7 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAppBlueprintCatalogItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
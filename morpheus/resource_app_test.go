@@ -0,0 +1,181 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeAppConfig(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "blueprint",
+		"terraform": map[string]interface{}{
+			"tfVersion": "1.5.0",
+			"tfvars":    map[string]interface{}{"region": "us-east-1", "size": "small"},
+		},
+	}
+	mergeAppConfig(config, map[string]interface{}{
+		"terraform": map[string]interface{}{
+			"tfvars": map[string]interface{}{"size": "large"},
+		},
+	})
+
+	expected := map[string]interface{}{
+		"name": "blueprint",
+		"terraform": map[string]interface{}{
+			"tfVersion": "1.5.0",
+			"tfvars":    map[string]interface{}{"region": "us-east-1", "size": "large"},
+		},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, config)
+	}
+}
+
+func TestOverrideAppTierInstances(t *testing.T) {
+	tier := map[string]interface{}{
+		"instances": []interface{}{
+			map[string]interface{}{"plan": map[string]interface{}{"id": float64(1)}},
+			map[string]interface{}{"plan": map[string]interface{}{"id": float64(1)}},
+		},
+	}
+	if err := overrideAppTierInstances(tier, `{"plan":{"id":2}}`); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for i, instance := range tier["instances"].([]interface{}) {
+		plan := instance.(map[string]interface{})["plan"].(map[string]interface{})
+		if plan["id"] != float64(2) {
+			t.Errorf("instance %d: expected the plan to be overridden, got %v", i, plan["id"])
+		}
+	}
+
+	if err := overrideAppTierInstances(tier, `not json`); err == nil {
+		t.Fatalf("expected an error for an invalid config")
+	}
+}
+
+func TestAppTierNames(t *testing.T) {
+	blueprintTiers := map[string]interface{}{
+		"web": map[string]interface{}{},
+		"db":  map[string]interface{}{},
+	}
+
+	names := appTierNames([]interface{}{
+		map[string]interface{}{"name": "web", "config": `{"plan":{"id":1}}`},
+	}, blueprintTiers)
+	expected := map[string]string{"web": `{"plan":{"id":1}}`}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected tiers %#v, got %#v", expected, names)
+	}
+
+	names = appTierNames(nil, blueprintTiers)
+	expected = map[string]string{"web": "", "db": ""}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected every blueprint tier %#v, got %#v", expected, names)
+	}
+}
+
+func TestChangedAppTiers(t *testing.T) {
+	oldTiers := map[string]string{"web": "", "app": `{"plan":{"id":1}}`, "db": ""}
+	newTiers := map[string]string{"web": "", "app": `{"plan":{"id":2}}`, "cache": ""}
+
+	removed := changedAppTiers(newTiers, oldTiers)
+	expected := map[string]string{"db": ""}
+	if !reflect.DeepEqual(removed, expected) {
+		t.Errorf("expected removed tiers %#v, got %#v", expected, removed)
+	}
+
+	added := changedAppTiers(oldTiers, newTiers)
+	expected = map[string]string{"cache": ""}
+	if !reflect.DeepEqual(added, expected) {
+		t.Errorf("expected added tiers %#v, got %#v", expected, added)
+	}
+}
+
+func TestAppTierConfigChanged(t *testing.T) {
+	web := map[string]interface{}{"name": "web", "config": ""}
+	app := map[string]interface{}{"name": "app", "config": `{"plan":{"id":1}}`}
+	appSpaced := map[string]interface{}{"name": "app", "config": `{ "plan": { "id": 1 } }`}
+	appResized := map[string]interface{}{"name": "app", "config": `{"plan":{"id":2}}`}
+
+	for _, tc := range []struct {
+		name     string
+		from     []interface{}
+		to       []interface{}
+		expected bool
+	}{
+		{name: "unchanged", from: []interface{}{web, app}, to: []interface{}{app, web}},
+		{name: "equivalent", from: []interface{}{app}, to: []interface{}{appSpaced}},
+		{name: "added", from: []interface{}{web}, to: []interface{}{web, app}},
+		{name: "removed", from: []interface{}{web, app}, to: []interface{}{web}},
+		{name: "changed", from: []interface{}{web, app}, to: []interface{}{web, appResized}, expected: true},
+		{name: "override every tier", from: nil, to: []interface{}{app}, expected: true},
+		{name: "select every tier", from: []interface{}{web}, to: nil},
+		{name: "drop overrides", from: []interface{}{app}, to: nil, expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if changed := appTierConfigChanged(tc.from, tc.to); changed != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, changed)
+			}
+		})
+	}
+}
+
+func TestRemoveAppTiers(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	webID := srv.seed("/api/instances", "instance", map[string]interface{}{"name": "web-1"})
+	dbID := srv.seed("/api/instances", "instance", map[string]interface{}{"name": "db-1"})
+	srv.stub("GET", "/api/apps/1", http.StatusOK, map[string]interface{}{
+		"app": map[string]interface{}{
+			"id": 1,
+			"appTiers": []interface{}{
+				map[string]interface{}{
+					"tier":         map[string]interface{}{"name": "web"},
+					"appInstances": []interface{}{map[string]interface{}{"instance": map[string]interface{}{"id": webID}}},
+				},
+				map[string]interface{}{
+					"tier":         map[string]interface{}{"name": "db"},
+					"appInstances": []interface{}{map[string]interface{}{"instance": map[string]interface{}{"id": dbID}}},
+				},
+			},
+		},
+	})
+	srv.stub("POST", "/api/apps/1/remove-instance", http.StatusOK, map[string]interface{}{"success": true})
+
+	meta, diags := (&Config{
+		Url:          srv.URL,
+		AccessToken:  "acctest",
+		PollDelay:    time.Millisecond,
+		PollInterval: time.Millisecond,
+	}).meta()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	d := resourceApp().TestResourceData()
+	d.SetId("1")
+	d.Set("force_delete", true)
+	d.Set("preserve_volumes", true)
+
+	if err := removeAppTiers(context.Background(), d, meta, map[string]string{"db": ""}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(srv.requests("DELETE", fmt.Sprintf("/api/instances/%d", webID))) != 0 {
+		t.Errorf("expected the instances of the kept tier not to be deleted")
+	}
+	deletes := srv.requests("DELETE", fmt.Sprintf("/api/instances/%d", dbID))
+	if len(deletes) != 1 {
+		t.Fatalf("expected the instance of the removed tier to be deleted once, got %d deletes", len(deletes))
+	}
+	query, _ := url.ParseQuery(deletes[0].Query)
+	if query.Get("force") != "true" || query.Get("preserveVolumes") != "on" {
+		t.Errorf("expected the delete options of the app to be sent, got %q", deletes[0].Query)
+	}
+	if len(srv.requests("GET", fmt.Sprintf("/api/instances/%d", dbID))) == 0 {
+		t.Errorf("expected removeAppTiers to wait for the instance to be removed")
+	}
+}
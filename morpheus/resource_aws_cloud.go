@@ -25,7 +25,7 @@ func resourceAWSCloud() *schema.Resource {
 		UpdateContext: resourceAWSCloudUpdate,
 		DeleteContext: resourceAWSCloudDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -197,7 +197,7 @@ func resourceAWSCloud() *schema.Resource {
 }
 
 func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -297,10 +297,10 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
@@ -316,7 +316,7 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -394,7 +394,7 @@ func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	if d.HasChange("name") {
@@ -516,7 +516,7 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAWSCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -21,7 +21,7 @@ func resourceAwsInstance() *schema.Resource {
 		UpdateContext: resourceAwsInstanceUpdate,
 		DeleteContext: resourceAwsInstanceDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(3 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -68,9 +68,8 @@ func resourceAwsInstance() *schema.Resource {
 				Required:    true,
 			},
 			"plan_id": {
-				Description: "The service plan associated with the instance",
+				Description: "The service plan associated with the instance, changing the plan resizes the instance",
 				Type:        schema.TypeInt,
-				ForceNew:    true,
 				Required:    true,
 			},
 			"resource_pool_id": {
@@ -193,7 +192,7 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 			"volumes": {
-				Description: "The instance volumes to create",
+				Description: "The instance volumes to create, volumes can be added, grown or removed by resizing the instance. Existing volumes are matched on the root flag and name, so volume names must be unique and cannot be changed. Removing every volume leaves the volumes of the instance unchanged",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -207,6 +206,7 @@ func resourceAwsInstance() *schema.Resource {
 							Description: "The name/type of the LV being created",
 							Type:        schema.TypeString,
 							Optional:    true,
+							Computed:    true,
 						},
 						"size": {
 							Description: "The size of the LV being created",
@@ -230,7 +230,7 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 			"interfaces": {
-				Description: "The instance network interfaces to create",
+				Description: "The instance network interfaces to create, network interfaces can be added or removed by resizing the instance. Existing network interfaces are matched on their network. Removing every interface leaves the network interfaces of the instance unchanged",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -294,7 +294,29 @@ func resourceAwsInstance() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"power_state": powerStateSchema(),
+			"force_delete": {
+				Description: "Whether to force the deletion of the instance, even if the removal of its resources fails. Defaults to the force_delete argument of the provider",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Description: "Whether to preserve the volumes of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keep_backups": {
+				Description: "Whether to keep the backups of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"release_ips": {
+				Description: "Whether to release the elastic and floating IP addresses of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 		},
+		CustomizeDiff: volumeRenamesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -302,7 +324,7 @@ func resourceAwsInstance() *schema.Resource {
 }
 
 func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -503,26 +525,36 @@ func resourceAwsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 			instance := result.Instance
 			return result, instance.Status, nil
 		},
-		Timeout:      3 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        3 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 3*time.Minute),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	created, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return diag.Errorf("error creating instance: %s", err)
 	}
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+
+	// Stop or suspend the instance once it is provisioned. The id is set
+	// first so the instance is kept in state if this fails
+	if powerState, ok := d.GetOk("power_state"); ok {
+		instanceStatus := created.(*morpheus.GetInstanceResult).Instance.Status
+		if err := setInstancePowerState(ctx, meta, instance.ID, instanceStatus, powerState.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error setting power state of instance: %s", err)
+		}
+	}
+
 	resourceAwsInstanceRead(ctx, d, meta)
 	return diags
 }
 
 func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -571,6 +603,7 @@ func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("resource_pool_id", instance.Config["resourcePoolId"])
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	setPowerStateFromStatus(d, instance.Status)
 	d.Set("evar", instance.EnvironmentVariables)
 	// Tags
 	tags := make(map[string]interface{})
@@ -603,11 +636,56 @@ func resourceAwsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("public_ip_type", instance.Config["publicIpType"])
 	d.Set("instance_profile_id", instance.Config["instanceProfile"])
 	d.Set("kms_key_id", instance.Config["kmsKeyId"])
+
+	// The volumes are only read back when they are configured, in the
+	// order of the configuration
+	if stateVolumes := d.Get("volumes").([]interface{}); len(stateVolumes) > 0 {
+		var volumes []map[string]interface{}
+		// iterate over the array of volumes
+		for i := 0; i < len(instance.Volumes); i++ {
+			row := make(map[string]interface{})
+			volume := instance.Volumes[i]
+			row["root"] = volume.RootVolume == true
+			row["name"] = volume.Name
+			row["size"] = apiInt64(volume.Size)
+			row["storage_type"] = apiInt64(volume.StorageType)
+			volumes = append(volumes, row)
+		}
+		volumes = orderVolumes(volumes, stateVolumes)
+		// The API does not return the size_id the volumes were created
+		// with, keep the configured values
+		for _, row := range volumes {
+			for _, v := range stateVolumes {
+				stateVolume := v.(map[string]interface{})
+				if sameVolume(row, stateVolume) {
+					row["size_id"] = stateVolume["size_id"]
+				}
+			}
+		}
+		d.Set("volumes", volumes)
+	}
+
+	var networkInterfaces []map[string]interface{}
+	// iterate over the array of interfaces
+	for i := 0; i < len(instance.Interfaces); i++ {
+		row := make(map[string]interface{})
+		networkInterface := instance.Interfaces[i]
+		row["network_id"] = int(networkInterface.Network.ID)
+		row["network_group"] = networkInterface.Network.Group
+		row["ip_address"] = networkInterface.IpAddress
+		row["ip_mode"] = networkInterface.IpMode
+		networkInterfaces = append(networkInterfaces, row)
+	}
+	// The interfaces are only read back when they are configured, in the
+	// order of the configuration
+	if stateInterfaces := d.Get("interfaces").([]interface{}); len(stateInterfaces) > 0 {
+		d.Set("interfaces", orderNetworkInterfaces(networkInterfaces, stateInterfaces))
+	}
 	return diags
 }
 
 func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -655,13 +733,54 @@ func resourceAwsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.UpdateInstanceResult)
 	instance := result.Instance
+
+	// Resize the instance when the plan, volumes or interfaces have changed
+	if d.HasChanges("plan_id", "volumes", "interfaces") {
+		instanceGetResp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", instanceGetResp, err)
+			return diag.FromErr(err)
+		}
+		fetchInstance := instanceGetResp.Result.(*morpheus.GetInstanceResult).Instance
+
+		resizePayload := map[string]interface{}{
+			"instance": map[string]interface{}{
+				"plan": map[string]interface{}{
+					"id": d.Get("plan_id"),
+				},
+			},
+		}
+		// The volumes and interfaces of the instance are left unchanged
+		// when none are configured
+		if volumes := d.Get("volumes").([]interface{}); len(volumes) > 0 {
+			storageVolumes := parseAwsStorageVolumes(volumes)
+			resizeVolumes(storageVolumes, fetchInstance.Volumes)
+			resizePayload["volumes"] = storageVolumes
+			resizePayload["deleteOriginalVolumes"] = true
+		}
+		if interfaces := d.Get("interfaces").([]interface{}); len(interfaces) > 0 {
+			networkInterfaces := parseAwsNetworkInterfaces(interfaces)
+			resizeNetworkInterfaces(networkInterfaces, fetchInstance.Interfaces)
+			resizePayload["networkInterfaces"] = networkInterfaces
+		}
+		if err := resizeInstance(ctx, d, meta, toInt64(id), resizePayload); err != nil {
+			return diag.Errorf("error resizing instance: %s", err)
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := setInstancePowerState(ctx, meta, toInt64(id), "", d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating power state of instance: %s", err)
+		}
+	}
+
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
 	return resourceAwsInstanceRead(ctx, d, meta)
 }
 
 func resourceAwsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -670,9 +789,7 @@ func resourceAwsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
-	if USE_FORCE {
-		req.QueryParams["force"] = "true"
-	}
+	deleteQueryParams(d, meta, req.QueryParams)
 	resp, err := client.DeleteInstance(toInt64(id), req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -24,7 +24,7 @@ func resourceAzureCloud() *schema.Resource {
 		UpdateContext: resourceAzureCloudUpdate,
 		DeleteContext: resourceAzureCloudDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -199,7 +199,7 @@ func resourceAzureCloud() *schema.Resource {
 }
 
 func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -301,10 +301,10 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
@@ -320,7 +320,7 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -401,7 +401,7 @@ func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	cloud["name"] = d.Get("name").(string)
@@ -494,7 +494,7 @@ func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAzureCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
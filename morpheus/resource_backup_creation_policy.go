@@ -108,7 +108,7 @@ func resourceBackupCreationPolicy() *schema.Resource {
 }
 
 func resourceBackupCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -185,7 +185,7 @@ func resourceBackupCreationPolicyCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -257,7 +257,7 @@ func resourceBackupCreationPolicyRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceBackupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -331,7 +331,7 @@ func resourceBackupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceBackupCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
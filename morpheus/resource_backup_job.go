@@ -0,0 +1,222 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBackupJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup job resource, which schedules the backups attached to it",
+		CreateContext: resourceBackupJobCreate,
+		ReadContext:   resourceBackupJobRead,
+		UpdateContext: resourceBackupJobUpdate,
+		DeleteContext: resourceBackupJobDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup job",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup job",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup job",
+				Optional:    true,
+				Computed:    true,
+			},
+			"execute_schedule_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the execute schedule that determines when the backup job runs",
+				Optional:    true,
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of backups to keep for each backup of the job",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"storage_provider_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage bucket or file share the backups of the job are stored in",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup job is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBackupJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   "/api/backups/jobs",
+		Body: map[string]interface{}{
+			"job": parseBackupJobPayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var backupJobPayload BackupJobPayload
+	if err := json.Unmarshal(resp.Body, &backupJobPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backupJobPayload.Job.ID))
+
+	resourceBackupJobRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/backups/jobs/%s", id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var backupJobPayload BackupJobPayload
+	if err := json.Unmarshal(resp.Body, &backupJobPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	backupJob := backupJobPayload.Job
+	d.SetId(int64ToString(backupJob.ID))
+	d.Set("name", backupJob.Name)
+	d.Set("code", backupJob.Code)
+	d.Set("execute_schedule_id", backupJob.Schedule.ID)
+	d.Set("retention_count", apiInt64(backupJob.RetentionCount))
+	d.Set("storage_provider_id", backupJob.StorageProvider.ID)
+	d.Set("enabled", backupJob.Enabled)
+	return diags
+}
+
+func resourceBackupJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/backups/jobs/%s", id),
+		Body: map[string]interface{}{
+			"job": parseBackupJobPayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceBackupJobRead(ctx, d, meta)
+}
+
+func resourceBackupJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/backups/jobs/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseBackupJobPayload(d *schema.ResourceData) map[string]interface{} {
+	backupJob := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"enabled": d.Get("enabled").(bool),
+	}
+	if code, ok := d.GetOk("code"); ok {
+		backupJob["code"] = code.(string)
+	}
+	if retentionCount, ok := d.GetOk("retention_count"); ok {
+		backupJob["retentionCount"] = retentionCount.(int)
+	}
+	// a nil id clears the schedule or storage provider
+	backupJob["schedule"] = map[string]interface{}{"id": nil}
+	if scheduleID, ok := d.GetOk("execute_schedule_id"); ok {
+		backupJob["schedule"] = map[string]interface{}{"id": scheduleID.(int)}
+	}
+	backupJob["storageProvider"] = map[string]interface{}{"id": nil}
+	if storageProviderID, ok := d.GetOk("storage_provider_id"); ok {
+		backupJob["storageProvider"] = map[string]interface{}{"id": storageProviderID.(int)}
+	}
+	return backupJob
+}
+
+type BackupJobPayload struct {
+	Job struct {
+		ID             int64       `json:"id"`
+		Name           string      `json:"name"`
+		Code           string      `json:"code"`
+		RetentionCount interface{} `json:"retentionCount"`
+		Enabled        bool        `json:"enabled"`
+		Schedule       struct {
+			ID int64 `json:"id"`
+		} `json:"schedule"`
+		StorageProvider struct {
+			ID int64 `json:"id"`
+		} `json:"storageProvider"`
+	} `json:"job"`
+}
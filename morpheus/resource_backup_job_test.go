@@ -0,0 +1,108 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusBackupJob_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_backup_job.tfacc"
+	backupName := "morpheus_instance_backup.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			srv.checkDestroy("morpheus_backup_job"),
+			srv.checkDestroy("morpheus_instance_backup"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusBackupJobConfig(7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "execute_schedule_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "retention_count", "7"),
+					resource.TestCheckResourceAttr(resourceName, "storage_provider_id", "5"),
+					resource.TestCheckResourceAttrPair(backupName, "job_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(backupName, "instance_id", "9"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusBackupJobConfig(14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "retention_count", "14"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestRunInstanceBackup(t *testing.T) {
+	for _, tc := range []struct {
+		status string
+		err    string
+	}{
+		{status: "SUCCEEDED"},
+		{status: "FAILED", err: "backup 1 is FAILED: disk is full"},
+	} {
+		t.Run(tc.status, func(t *testing.T) {
+			srv := newFakeMorpheusServer(t)
+			srv.stub("POST", "/api/backups/1/execute", http.StatusOK, map[string]interface{}{"success": true})
+			srv.stub("GET", "/api/backups/results", http.StatusOK, map[string]interface{}{
+				"results": []interface{}{
+					map[string]interface{}{"id": 1, "status": tc.status, "errorMessage": "disk is full"},
+				},
+			})
+
+			meta, diags := (&Config{
+				Url:          srv.URL,
+				AccessToken:  "acctest",
+				PollDelay:    time.Millisecond,
+				PollInterval: time.Millisecond,
+			}).meta()
+			if diags.HasError() {
+				t.Fatalf("unexpected error creating client: %v", diags)
+			}
+
+			err := runInstanceBackup(context.Background(), meta, 1, time.Minute)
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+			if len(srv.requests("POST", "/api/backups/1/execute")) != 1 {
+				t.Fatalf("expected the backup to be executed once")
+			}
+		})
+	}
+}
+
+func testAccMorpheusBackupJobConfig(retentionCount int) string {
+	return fmt.Sprintf(`
+resource "morpheus_backup_job" "tfacc" {
+  name                = "tfacc"
+  execute_schedule_id = 3
+  retention_count     = %d
+  storage_provider_id = 5
+}
+
+resource "morpheus_instance_backup" "tfacc" {
+  instance_id = 9
+  name        = "tfacc"
+  job_id      = morpheus_backup_job.tfacc.id
+}
+`, retentionCount)
+}
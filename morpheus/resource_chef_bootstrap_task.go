@@ -129,7 +129,7 @@ func resourceChefBootstrapTask() *schema.Resource {
 }
 
 func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -189,7 +189,7 @@ func resourceChefBootstrapTaskCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -246,7 +246,7 @@ func resourceChefBootstrapTaskRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -303,7 +303,7 @@ func resourceChefBootstrapTaskUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceChefBootstrapTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -109,7 +109,7 @@ func resourceCloudFormationAppBlueprint() *schema.Resource {
 }
 
 func resourceCloudFormationAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -181,7 +181,7 @@ func resourceCloudFormationAppBlueprintCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -245,7 +245,7 @@ func resourceCloudFormationAppBlueprintRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -312,7 +312,7 @@ func resourceCloudFormationAppBlueprintUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -85,7 +85,7 @@ func resourceCloudFormationSpecTemplate() *schema.Resource {
 }
 
 func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -154,7 +154,7 @@ func resourceCloudFormationSpecTemplateCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -230,7 +230,7 @@ func resourceCloudFormationSpecTemplateRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -294,7 +294,7 @@ func resourceCloudFormationSpecTemplateUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceCloudFormationSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
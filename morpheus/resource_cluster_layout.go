@@ -204,7 +204,7 @@ func resourceClusterLayout() *schema.Resource {
 }
 
 func resourceClusterLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -299,7 +299,7 @@ func resourceClusterLayoutCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceClusterLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -399,7 +399,7 @@ func resourceClusterLayoutRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceClusterLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	clusterLayout := make(map[string]interface{})
@@ -487,7 +487,7 @@ func resourceClusterLayoutUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceClusterLayoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
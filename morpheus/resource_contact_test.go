@@ -0,0 +1,49 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusContact_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_contact.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_contact"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusContactConfig("tfacc", "tfacc@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", "tfacc@example.com"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusContactConfig("tfacc", "ops@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email_address", "ops@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusContactConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "morpheus_contact" "tfacc" {
+  name          = %q
+  email_address = %q
+  mobile_number = "5555555555"
+}
+`, name, email)
+}
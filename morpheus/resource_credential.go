@@ -118,6 +118,20 @@ func resourceCredential() *schema.Resource {
 					return strings.EqualFold(old, sha256_hash)
 				},
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Description:   "The credential password, which is not stored in state. Requires Terraform 1.11 or later",
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:        schema.TypeInt,
+				Description: "The version of the write-only credential password, which must be changed to update the password",
+				Optional:    true,
+			},
 			"api_key": {
 				Type:        schema.TypeString,
 				Description: "The credential api key",
@@ -143,7 +157,7 @@ func resourceCredential() *schema.Resource {
 }
 
 func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -195,11 +209,11 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 	case "username-password":
 		credential["type"] = "username-password"
 		credential["username"] = d.Get("username").(string)
-		credential["password"] = d.Get("password").(string)
+		credential["password"] = credentialPassword(d)
 	case "username-password-keypair":
 		credential["type"] = "username-password-keypair"
 		credential["username"] = d.Get("username").(string)
-		credential["password"] = d.Get("password").(string)
+		credential["password"] = credentialPassword(d)
 		keypair := make(map[string]interface{})
 		keypair["id"] = d.Get("key_pair_id").(int)
 		credential["authKey"] = keypair
@@ -228,7 +242,7 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -294,10 +308,14 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 			d.Set("key_pair_id", credential.AuthKey.ID)
 		case "username-password":
 			d.Set("username", credential.Username)
-			d.Set("password", credential.PasswordHash)
+			if _, ok := d.GetOk("password_wo_version"); !ok {
+				d.Set("password", credential.PasswordHash)
+			}
 		case "username-password-keypair":
 			d.Set("username", credential.Username)
-			d.Set("password", credential.PasswordHash)
+			if _, ok := d.GetOk("password_wo_version"); !ok {
+				d.Set("password", credential.PasswordHash)
+			}
 			d.Set("key_pair_id", credential.AuthKey.ID)
 		}
 	} else {
@@ -308,7 +326,7 @@ func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	credential := make(map[string]interface{})
 	credential["name"] = d.Get("name").(string)
@@ -353,15 +371,19 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 	case "username-password":
 		credential["type"] = "username-password"
 		credential["username"] = d.Get("username").(string)
-		credential["password"] = d.Get("password").(string)
+		credential["password"] = credentialPassword(d)
 	case "username-password-keypair":
 		credential["type"] = "username-password-keypair"
 		credential["username"] = d.Get("username").(string)
-		credential["password"] = d.Get("password").(string)
+		credential["password"] = credentialPassword(d)
 		keypair := make(map[string]interface{})
 		keypair["id"] = d.Get("key_pair_id").(int)
 		credential["authKey"] = keypair
 	}
+	// The write-only password is only sent when its version changes
+	if _, ok := d.GetOk("password_wo_version"); ok && !d.HasChange("password_wo_version") {
+		delete(credential, "password")
+	}
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -383,7 +405,7 @@ func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -404,3 +426,12 @@ func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId("")
 	return diags
 }
+
+// credentialPassword returns the password of the credential from either the
+// password or the write-only password_wo argument.
+func credentialPassword(d *schema.ResourceData) string {
+	if password, ok := d.GetOk("password"); ok {
+		return password.(string)
+	}
+	return writeOnlyString(d, "password_wo")
+}
@@ -0,0 +1,218 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCypherKey() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cypher key resource, which stores a base64 encoded encryption key generated by Morpheus in the key cypher mount",
+		CreateContext: resourceCypherKeyCreate,
+		ReadContext:   resourceCypherKeyRead,
+		UpdateContext: resourceCypherKeyUpdate,
+		DeleteContext: resourceCypherKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the cypher key",
+				Computed:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "The path of the cypher key, excluding the key and bit size prefix",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bits": {
+				Type:         schema.TypeInt,
+				Description:  "The bit size of the generated key (128, 192, 256)",
+				Optional:     true,
+				Default:      256,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{128, 192, 256}),
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time to live of the cypher key",
+				Optional:    true,
+				Computed:    true,
+			},
+			"renew_before": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds before the lease of the cypher key expires that the key is written again to renew it",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, generates a new key",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds until the lease of the cypher key expires",
+				Computed:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The base64 encoded key generated by Morpheus",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		CustomizeDiff: cypherRotationCustomizeDiff(true),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCypherKeyImport,
+		},
+	}
+}
+
+func resourceCypherKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// the key mount generates the value when none is given
+	req := &morpheus.Request{
+		Body: map[string]interface{}{},
+		QueryParams: map[string]string{
+			"ttl": strconv.Itoa(d.Get("ttl").(int)),
+		},
+	}
+
+	resp, err := client.CreateCypher(cypherKeyPath(d), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateCypherResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Cypher.ID))
+
+	resourceCypherKeyRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCypherKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/cypher/%s", cypherKeyPath(d)),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*LocalGetCypherResult)
+	if result.Cypher == nil {
+		return diag.Errorf("read operation: cypher not found in response data") // should not happen
+	}
+	d.SetId(int64ToString(result.Cypher.ID))
+	if _, ok := d.GetOk("ttl"); !ok {
+		d.Set("ttl", result.LeaseDuration)
+	}
+	d.Set("lease_duration", result.LeaseDuration)
+	if cypherLeaseExpiring(d.Get("ttl").(int), int(result.LeaseDuration), d.Get("renew_before").(int)) {
+		log.Printf("Lease of cypher %s expires in %d seconds, scheduling renewal", result.Cypher.ItemKey, result.LeaseDuration)
+	}
+	if value, ok := result.Data.(string); ok {
+		d.Set("value", value)
+	}
+	return diags
+}
+
+func resourceCypherKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// writing the key again renews its lease, the key mount generates a
+	// new value when none is given
+	body := map[string]interface{}{}
+	if !d.HasChange("rotation_trigger") {
+		body["value"] = d.Get("value").(string)
+	}
+	req := &morpheus.Request{
+		Body: body,
+		QueryParams: map[string]string{
+			"ttl": strconv.Itoa(d.Get("ttl").(int)),
+		},
+	}
+
+	resp, err := client.CreateCypher(cypherKeyPath(d), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	return resourceCypherKeyRead(ctx, d, meta)
+}
+
+func resourceCypherKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.DeleteCypher(cypherKeyPath(d), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceCypherKeyImport imports a cypher key by its path in the form
+// <bits>/<key>, such as 256/app/encryptionkey.
+func resourceCypherKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	bits, err := strconv.Atoi(parts[0])
+	if len(parts) != 2 || err != nil || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <bits>/<key>", d.Id())
+	}
+	d.Set("bits", bits)
+	d.Set("key", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func cypherKeyPath(d *schema.ResourceData) string {
+	return fmt.Sprintf("key/%d/%s", d.Get("bits").(int), d.Get("key").(string))
+}
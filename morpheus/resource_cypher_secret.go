@@ -2,6 +2,7 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCypherSecret() *schema.Resource {
@@ -18,6 +21,7 @@ func resourceCypherSecret() *schema.Resource {
 		Description:   "Provides a Morpheus cypher secret resource.",
 		CreateContext: resourceCypherSecretCreate,
 		ReadContext:   resourceCypherSecretRead,
+		UpdateContext: resourceCypherSecretUpdate,
 		DeleteContext: resourceCypherSecretDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -26,27 +30,88 @@ func resourceCypherSecret() *schema.Resource {
 				Description: "The ID of the cypher secret",
 				Computed:    true,
 			},
+			"mount": {
+				Type:         schema.TypeString,
+				Description:  "The cypher mount the secret is stored in, secret stores it in Morpheus and vault stores it in the HashiCorp Vault integration (secret, vault)",
+				Optional:     true,
+				Default:      "secret",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"secret", "vault"}, false),
+			},
 			"key": {
 				Type:        schema.TypeString,
-				Description: "The path of the cypher secret, excluding the secret prefix",
+				Description: "The path of the cypher secret, excluding the mount prefix",
 				Required:    true,
 				ForceNew:    true,
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Description: "The value of the cypher secret",
-				Required:    true,
-				Sensitive:   true,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Description:  "The string value of the cypher secret",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_json", "value_map", "value_wo"},
+			},
+			"value_json": {
+				Type:             schema.TypeString,
+				Description:      "The value of the cypher secret as JSON, stored as an object instead of a string",
+				Optional:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"value", "value_json", "value_map", "value_wo"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"value_map": {
+				Type:         schema.TypeMap,
+				Description:  "The value of the cypher secret as a map of strings, stored as an object instead of a string",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_json", "value_map", "value_wo"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Description:  "The string value of the cypher secret, which is not stored in state. Requires Terraform 1.11 or later",
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"value", "value_json", "value_map", "value_wo"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+			"value_wo_version": {
+				Type:        schema.TypeInt,
+				Description: "The version of the write-only value, which must be changed to write the cypher secret again",
+				Optional:    true,
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
 				Description: "The time to live of the cypher secret",
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"renew_before": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds before the lease of the cypher secret expires that the secret is written again to renew it",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, writes the cypher secret again",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds until the lease of the cypher secret expires",
+				Computed:    true,
 			},
 		},
+		CustomizeDiff: cypherRotationCustomizeDiff(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,23 +119,26 @@ func resourceCypherSecret() *schema.Resource {
 }
 
 func resourceCypherSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	value, valueType, err := parseCypherSecretValue(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"value": d.Get("value").(string),
+			"value": value,
 		},
 		QueryParams: map[string]string{
 			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
-			"type": "string",
+			"type": valueType,
 		},
 	}
 
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-	resp, err := client.CreateCypher(secretPath, req)
+	resp, err := client.CreateCypher(cypherSecretPath(d), req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
 		return diag.FromErr(err)
@@ -87,7 +155,7 @@ func resourceCypherSecretCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -96,8 +164,7 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	var resp *morpheus.Response
 	var err error
 	if id != "" {
-		secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-		resp, err = client.GetCypher(secretPath, &morpheus.Request{})
+		resp, err = client.GetCypher(cypherSecretPath(d), &morpheus.Request{})
 	} else {
 		return diag.Errorf("Cypher cannot be read without id")
 	}
@@ -121,9 +188,16 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	if result.Cypher != nil {
 		d.SetId(int64ToString(result.Cypher.ID))
 		keyData := strings.Split(result.Cypher.ItemKey, "/")
+		d.Set("mount", keyData[0])
 		keyData = keyData[1:]
 		d.Set("key", strings.Join(keyData, "/"))
-		d.Set("ttl", result.LeaseDuration)
+		if _, ok := d.GetOk("ttl"); !ok {
+			d.Set("ttl", result.LeaseDuration)
+		}
+		d.Set("lease_duration", result.LeaseDuration)
+		if cypherLeaseExpiring(d.Get("ttl").(int), int(result.LeaseDuration), d.Get("renew_before").(int)) {
+			log.Printf("Lease of cypher %s expires in %d seconds, scheduling renewal", cypherSecretPath(d), result.LeaseDuration)
+		}
 	} else {
 		return diag.Errorf("read operation: contact not found in response data") // should not happen
 	}
@@ -131,15 +205,42 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceCypherSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	value, valueType, err := parseCypherSecretValue(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// writing the key again replaces the value in place and renews its lease
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"value": value,
+		},
+		QueryParams: map[string]string{
+			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
+			"type": valueType,
+		},
+	}
+
+	resp, err := client.CreateCypher(cypherSecretPath(d), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	return resourceCypherSecretRead(ctx, d, meta)
+}
+
 func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
 	req := &morpheus.Request{}
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
-	resp, err := client.DeleteCypher(secretPath, req)
+	resp, err := client.DeleteCypher(cypherSecretPath(d), req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("API 404: %s - %s", resp, err)
@@ -153,3 +254,53 @@ func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// parseCypherSecretValue returns the value of a cypher secret and the type
+// it is stored as, decoding JSON and map values into an object. The
+// write-only value_wo is read from the configuration.
+func parseCypherSecretValue(d *schema.ResourceData) (interface{}, string, error) {
+	if valueJSON, ok := d.GetOk("value_json"); ok {
+		var value interface{}
+		if err := json.Unmarshal([]byte(valueJSON.(string)), &value); err != nil {
+			return nil, "", err
+		}
+		return value, "object", nil
+	}
+	if valueMap, ok := d.GetOk("value_map"); ok {
+		return valueMap.(map[string]interface{}), "object", nil
+	}
+	if value, ok := d.GetOk("value"); ok {
+		return value.(string), "string", nil
+	}
+	return writeOnlyString(d, "value_wo"), "string", nil
+}
+
+func cypherSecretPath(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s", d.Get("mount").(string), d.Get("key").(string))
+}
+
+// cypherLeaseExpiring reports whether the lease of a cypher with the given
+// ttl expires within renewBefore seconds. A ttl of 0 never expires.
+func cypherLeaseExpiring(ttl, leaseDuration, renewBefore int) bool {
+	return ttl > 0 && leaseDuration <= renewBefore
+}
+
+// cypherRotationCustomizeDiff plans the cypher to be written again when its
+// lease is about to expire, so it is renewed before consumers read nothing.
+// Generated cyphers are also regenerated when their rotation trigger changes.
+func cypherRotationCustomizeDiff(generated bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		if generated && d.HasChange("rotation_trigger") {
+			if err := d.SetNewComputed("value"); err != nil {
+				return err
+			}
+		}
+		if cypherLeaseExpiring(d.Get("ttl").(int), d.Get("lease_duration").(int), d.Get("renew_before").(int)) {
+			return d.SetNewComputed("lease_duration")
+		}
+		return nil
+	}
+}
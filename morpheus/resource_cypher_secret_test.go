@@ -0,0 +1,173 @@
+package morpheus
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMorpheusCypherSecret_valueMap(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_cypher_secret.tfacc"
+	cypher := map[string]interface{}{"id": 1, "itemKey": "vault/app1/database"}
+	srv.stub("POST", "/api/cypher/vault/app1/database", http.StatusOK, map[string]interface{}{"success": true, "cypher": cypher})
+	srv.stub("GET", "/api/cypher/vault/app1/database", http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"type":           "object",
+		"data":           map[string]interface{}{"username": "app1", "password": "password123"},
+		"lease_duration": 3600,
+		"cypher":         cypher,
+	})
+	srv.stub("DELETE", "/api/cypher/vault/app1/database", http.StatusOK, map[string]interface{}{"success": true})
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusCypherSecretConfig("password123"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mount", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "key", "app1/database"),
+					resource.TestCheckResourceAttr(resourceName, "value_map.username", "app1"),
+					func(*terraform.State) error {
+						posts := srv.requests("POST", "/api/cypher/vault/app1/database")
+						if len(posts) != 1 {
+							return fmt.Errorf("expected 1 cypher write, got %d", len(posts))
+						}
+						if posts[0].Query != "ttl=3600&type=object" {
+							return fmt.Errorf("unexpected cypher write query %q", posts[0].Query)
+						}
+						value := posts[0].RequestBody.(map[string]interface{})["value"]
+						if _, ok := value.(map[string]interface{}); !ok {
+							return fmt.Errorf("expected the cypher value to be written as an object, got %v", value)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusCypherSecretConfig("password456"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_map.password", "password456"),
+					func(*terraform.State) error {
+						if deletes := srv.requests("DELETE", "/api/cypher/vault/app1/database"); len(deletes) != 0 {
+							return fmt.Errorf("expected the cypher secret to be updated in place, got %d deletes", len(deletes))
+						}
+						if posts := srv.requests("POST", "/api/cypher/vault/app1/database"); len(posts) != 2 {
+							return fmt.Errorf("expected 2 cypher writes, got %d", len(posts))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccMorpheusCypherSecret_leaseRenewal(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	cypher := map[string]interface{}{"id": 1, "itemKey": "secret/app1/token"}
+	srv.stub("POST", "/api/cypher/secret/app1/token", http.StatusOK, map[string]interface{}{"success": true, "cypher": cypher})
+	srv.stub("GET", "/api/cypher/secret/app1/token", http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"type":           "string",
+		"data":           "token123",
+		"lease_duration": 120,
+		"cypher":         cypher,
+	})
+	srv.stub("DELETE", "/api/cypher/secret/app1/token", http.StatusOK, map[string]interface{}{"success": true})
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "morpheus_cypher_secret" "tfacc" {
+  key   = "app1/token"
+  value = "token123"
+  ttl   = 3600
+}
+`,
+				Check: resource.TestCheckResourceAttr("morpheus_cypher_secret.tfacc", "lease_duration", "120"),
+				// the lease expires within renew_before, so a renewal is planned
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestCypherLeaseExpiring(t *testing.T) {
+	for _, tc := range []struct {
+		ttl, leaseDuration, renewBefore int
+		expiring                        bool
+	}{
+		{ttl: 0, leaseDuration: 0, renewBefore: 300, expiring: false},
+		{ttl: 3600, leaseDuration: 3600, renewBefore: 300, expiring: false},
+		{ttl: 3600, leaseDuration: 300, renewBefore: 300, expiring: true},
+		{ttl: 3600, leaseDuration: 0, renewBefore: 0, expiring: true},
+	} {
+		if got := cypherLeaseExpiring(tc.ttl, tc.leaseDuration, tc.renewBefore); got != tc.expiring {
+			t.Errorf("cypherLeaseExpiring(%d, %d, %d) = %t, expected %t", tc.ttl, tc.leaseDuration, tc.renewBefore, got, tc.expiring)
+		}
+	}
+}
+
+func testAccMorpheusCypherSecretConfig(password string) string {
+	return fmt.Sprintf(`
+resource "morpheus_cypher_secret" "tfacc" {
+  mount = "vault"
+  key   = "app1/database"
+  ttl   = 3600
+  value_map = {
+    username = "app1"
+    password = %q
+  }
+}
+`, password)
+}
+
+func TestAccMorpheusCypherPassword_generated(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_cypher_password.tfacc"
+	cypher := map[string]interface{}{"id": 2, "itemKey": "password/20/app1/dbpassword"}
+	srv.stub("POST", "/api/cypher/password/20/app1/dbpassword", http.StatusOK, map[string]interface{}{"success": true, "cypher": cypher})
+	srv.stub("GET", "/api/cypher/password/20/app1/dbpassword", http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"type":           "string",
+		"data":           "Zq8!rT2mXw4#Lp9sVb7e",
+		"lease_duration": 0,
+		"cypher":         cypher,
+	})
+	srv.stub("DELETE", "/api/cypher/password/20/app1/dbpassword", http.StatusOK, map[string]interface{}{"success": true})
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "morpheus_cypher_password" "tfacc" {
+  key    = "app1/dbpassword"
+  length = 20
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value", "Zq8!rT2mXw4#Lp9sVb7e"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "20/app1/dbpassword",
+				ImportStateVerify: true,
+				// renew_before is not known to the API
+				ImportStateVerifyIgnore: []string{"renew_before"},
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCypherTFVars() *schema.Resource {
@@ -18,6 +19,7 @@ func resourceCypherTFVars() *schema.Resource {
 		Description:   "Provides a Morpheus cypher tfvars secret resource.",
 		CreateContext: resourceCypherTFVarsCreate,
 		ReadContext:   resourceCypherTFVarsRead,
+		UpdateContext: resourceCypherTFVarsUpdate,
 		DeleteContext: resourceCypherTFVarsDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -37,16 +39,33 @@ func resourceCypherTFVars() *schema.Resource {
 				Description: "The value of the cypher tfvars secret",
 				Required:    true,
 				Sensitive:   true,
-				ForceNew:    true,
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
 				Description: "The time to live of the cypher tfvars secret",
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"renew_before": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds before the lease of the cypher tfvars secret expires that the secret is written again to renew it",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, writes the cypher tfvars secret again",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds until the lease of the cypher tfvars secret expires",
+				Computed:    true,
 			},
 		},
+		CustomizeDiff: cypherRotationCustomizeDiff(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +73,7 @@ func resourceCypherTFVars() *schema.Resource {
 }
 
 func resourceCypherTFVarsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -87,7 +106,7 @@ func resourceCypherTFVarsCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -123,7 +142,13 @@ func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta
 		keyData := strings.Split(result.Cypher.ItemKey, "/")
 		keyData = keyData[1:]
 		d.Set("key", strings.Join(keyData, "/"))
-		d.Set("ttl", result.LeaseDuration)
+		if _, ok := d.GetOk("ttl"); !ok {
+			d.Set("ttl", result.LeaseDuration)
+		}
+		d.Set("lease_duration", result.LeaseDuration)
+		if cypherLeaseExpiring(d.Get("ttl").(int), int(result.LeaseDuration), d.Get("renew_before").(int)) {
+			log.Printf("Lease of cypher tfvars/%s expires in %d seconds, scheduling renewal", d.Get("key").(string), result.LeaseDuration)
+		}
 	} else {
 		return diag.Errorf("read operation: cypher not found in response data") // should not happen
 	}
@@ -131,8 +156,33 @@ func resourceCypherTFVarsRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceCypherTFVarsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// writing the key again replaces the value in place and renews its lease
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"value": d.Get("value").(string),
+		},
+		QueryParams: map[string]string{
+			"ttl":  strconv.Itoa(d.Get("ttl").(int)),
+			"type": "string",
+		},
+	}
+
+	tfvarsPath := fmt.Sprintf("tfvars/%s", d.Get("key").(string))
+	resp, err := client.CreateCypher(tfvarsPath, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	//log.Printf("API RESPONSE: %s", resp)
+	return resourceCypherTFVarsRead(ctx, d, meta)
+}
+
 func resourceCypherTFVarsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
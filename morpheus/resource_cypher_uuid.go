@@ -0,0 +1,202 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCypherUUID() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cypher UUID resource, which stores a UUID generated by Morpheus in the uuid cypher mount",
+		CreateContext: resourceCypherUUIDCreate,
+		ReadContext:   resourceCypherUUIDRead,
+		UpdateContext: resourceCypherUUIDUpdate,
+		DeleteContext: resourceCypherUUIDDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the cypher UUID",
+				Computed:    true,
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "The path of the cypher UUID, excluding the uuid prefix",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time to live of the cypher UUID",
+				Optional:    true,
+				Computed:    true,
+			},
+			"renew_before": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds before the lease of the cypher UUID expires that the UUID is written again to renew it",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary values that, when changed, generates a new UUID",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds until the lease of the cypher UUID expires",
+				Computed:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The UUID generated by Morpheus",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		CustomizeDiff: cypherRotationCustomizeDiff(true),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCypherUUIDImport,
+		},
+	}
+}
+
+func resourceCypherUUIDCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// the uuid mount generates the value when none is given
+	req := &morpheus.Request{
+		Body: map[string]interface{}{},
+		QueryParams: map[string]string{
+			"ttl": strconv.Itoa(d.Get("ttl").(int)),
+		},
+	}
+
+	uuidPath := fmt.Sprintf("uuid/%s", d.Get("key").(string))
+	resp, err := client.CreateCypher(uuidPath, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateCypherResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Cypher.ID))
+
+	resourceCypherUUIDRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCypherUUIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/cypher/uuid/%s", d.Get("key").(string)),
+		Result: &LocalGetCypherResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*LocalGetCypherResult)
+	if result.Cypher == nil {
+		return diag.Errorf("read operation: cypher not found in response data") // should not happen
+	}
+	d.SetId(int64ToString(result.Cypher.ID))
+	if _, ok := d.GetOk("ttl"); !ok {
+		d.Set("ttl", result.LeaseDuration)
+	}
+	d.Set("lease_duration", result.LeaseDuration)
+	if cypherLeaseExpiring(d.Get("ttl").(int), int(result.LeaseDuration), d.Get("renew_before").(int)) {
+		log.Printf("Lease of cypher %s expires in %d seconds, scheduling renewal", result.Cypher.ItemKey, result.LeaseDuration)
+	}
+	if value, ok := result.Data.(string); ok {
+		d.Set("value", value)
+	}
+	return diags
+}
+
+func resourceCypherUUIDUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// writing the key again renews its lease, the uuid mount generates a
+	// new value when none is given
+	body := map[string]interface{}{}
+	if !d.HasChange("rotation_trigger") {
+		body["value"] = d.Get("value").(string)
+	}
+	req := &morpheus.Request{
+		Body: body,
+		QueryParams: map[string]string{
+			"ttl": strconv.Itoa(d.Get("ttl").(int)),
+		},
+	}
+
+	uuidPath := fmt.Sprintf("uuid/%s", d.Get("key").(string))
+	resp, err := client.CreateCypher(uuidPath, req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	return resourceCypherUUIDRead(ctx, d, meta)
+}
+
+func resourceCypherUUIDDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	uuidPath := fmt.Sprintf("uuid/%s", d.Get("key").(string))
+	resp, err := client.DeleteCypher(uuidPath, &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceCypherUUIDImport imports a cypher UUID by its key, such as
+// app/instanceid.
+func resourceCypherUUIDImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("key", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
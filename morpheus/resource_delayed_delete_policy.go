@@ -103,7 +103,7 @@ func resourceDelayedDeletePolicy() *schema.Resource {
 }
 
 func resourceDelayedDeletePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -173,7 +173,7 @@ func resourceDelayedDeletePolicyCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -245,7 +245,7 @@ func resourceDelayedDeletePolicyRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceDelayedDeletePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -312,7 +312,7 @@ func resourceDelayedDeletePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDelayedDeletePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -114,7 +114,7 @@ func resourceDeleteApprovalPolicy() *schema.Resource {
 }
 
 func resourceDeleteApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -188,7 +188,7 @@ func resourceDeleteApprovalPolicyCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -269,7 +269,7 @@ func resourceDeleteApprovalPolicyRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -340,7 +340,7 @@ func resourceDeleteApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceDeleteApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
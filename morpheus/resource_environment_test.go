@@ -0,0 +1,51 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusEnvironment_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_environment.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_environment"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusEnvironmentConfig("tfacc", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "private"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusEnvironmentConfig("tfacc-renamed", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusEnvironmentConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "morpheus_environment" "tfacc" {
+  name        = %q
+  description = %q
+  code        = "tfacc"
+}
+`, name, description)
+}
@@ -59,7 +59,7 @@ func resourceExecuteSchedule() *schema.Resource {
 }
 
 func resourceExecuteScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -95,7 +95,7 @@ func resourceExecuteScheduleCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceExecuteScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -141,7 +141,7 @@ func resourceExecuteScheduleRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceExecuteScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	schedule := make(map[string]interface{})
@@ -175,7 +175,7 @@ func resourceExecuteScheduleUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceExecuteScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
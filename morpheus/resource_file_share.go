@@ -0,0 +1,314 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fileShareProviders maps the provider blocks of a file share to the
+// config keys the API expects for each attribute of the block
+var fileShareProviders = map[string]map[string]string{
+	"cifs": {
+		"host":       "host",
+		"share_path": "exportFolder",
+		"username":   "username",
+		"password":   "password",
+	},
+	"nfs": {
+		"host":          "host",
+		"export_folder": "exportFolder",
+	},
+	"local": {
+		"base_path": "basePath",
+	},
+}
+
+func resourceFileShare() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus file share resource, used as a target for backups, deployments, virtual images and archives",
+		CreateContext: resourceFileShareCreate,
+		ReadContext:   resourceFileShareRead,
+		UpdateContext: resourceFileShareUpdate,
+		DeleteContext: resourceFileShareDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the file share",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the file share",
+				Required:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"default_backup_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for backups",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_deployment_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for deployment archives",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_virtual_image_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for virtual images",
+				Optional:    true,
+				Default:     false,
+			},
+			"retention_policy_type": {
+				Type:        schema.TypeString,
+				Description: "The retention policy of the files in the file share (none, backup, delete)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"retention_policy_days": {
+				Type:        schema.TypeInt,
+				Description: "The number of days files are kept in the file share before the retention policy is applied",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cifs": {
+				Type:         schema.TypeList,
+				Description:  "The settings of a CIFS (SMB) file share",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cifs", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The hostname or IP address of the CIFS server",
+							Required:    true,
+						},
+						"share_path": {
+							Type:        schema.TypeString,
+							Description: "The path of the share on the CIFS server",
+							Required:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The username used to authenticate with the CIFS server",
+							Optional:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "The password used to authenticate with the CIFS server",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"nfs": {
+				Type:         schema.TypeList,
+				Description:  "The settings of an NFSv3 file share",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cifs", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The hostname or IP address of the NFS server",
+							Required:    true,
+						},
+						"export_folder": {
+							Type:        schema.TypeString,
+							Description: "The exported folder on the NFS server",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"local": {
+				Type:         schema.TypeList,
+				Description:  "The settings of a file share on the local disk of the Morpheus appliance",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cifs", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_path": {
+							Type:        schema.TypeString,
+							Description: "The path of the file share on the Morpheus appliance",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: storageProviderCustomizeDiff(fileShareProviders),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceFileShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   "/api/file-shares",
+		Body: map[string]interface{}{
+			"fileShare": parseFileSharePayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var fileSharePayload FileSharePayload
+	if err := json.Unmarshal(resp.Body, &fileSharePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(fileSharePayload.FileShare.ID))
+
+	resourceFileShareRead(ctx, d, meta)
+	return diags
+}
+
+func resourceFileShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/file-shares/%s", id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var fileSharePayload FileSharePayload
+	if err := json.Unmarshal(resp.Body, &fileSharePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	fileShare := fileSharePayload.FileShare
+	d.SetId(int64ToString(fileShare.ID))
+	d.Set("name", fileShare.Name)
+	d.Set("active", fileShare.Active)
+	d.Set("default_backup_target", fileShare.DefaultBackupTarget)
+	d.Set("default_deployment_target", fileShare.DefaultDeploymentTarget)
+	d.Set("default_virtual_image_target", fileShare.DefaultVirtualImageTarget)
+	d.Set("retention_policy_type", fileShare.RetentionPolicyType)
+	d.Set("retention_policy_days", apiInt64(fileShare.RetentionPolicyDays))
+	setStorageProviderConfig(d, fileShareProviders, fileShare.ProviderType, fileShare.Config)
+	return diags
+}
+
+func resourceFileShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/file-shares/%s", id),
+		Body: map[string]interface{}{
+			"fileShare": parseFileSharePayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceFileShareRead(ctx, d, meta)
+}
+
+func resourceFileShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/file-shares/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseFileSharePayload(d *schema.ResourceData) map[string]interface{} {
+	providerType, config := parseStorageProviderConfig(d, fileShareProviders)
+	fileShare := map[string]interface{}{
+		"name":                      d.Get("name").(string),
+		"providerType":              providerType,
+		"active":                    d.Get("active").(bool),
+		"defaultBackupTarget":       d.Get("default_backup_target").(bool),
+		"defaultDeploymentTarget":   d.Get("default_deployment_target").(bool),
+		"defaultVirtualImageTarget": d.Get("default_virtual_image_target").(bool),
+		"config":                    config,
+	}
+	if retentionPolicyType, ok := d.GetOk("retention_policy_type"); ok {
+		fileShare["retentionPolicyType"] = retentionPolicyType.(string)
+	}
+	if retentionPolicyDays, ok := d.GetOk("retention_policy_days"); ok {
+		fileShare["retentionPolicyDays"] = retentionPolicyDays.(int)
+	}
+	return fileShare
+}
+
+type FileSharePayload struct {
+	FileShare struct {
+		ID                        int64                  `json:"id"`
+		Name                      string                 `json:"name"`
+		ProviderType              string                 `json:"providerType"`
+		Active                    bool                   `json:"active"`
+		DefaultBackupTarget       bool                   `json:"defaultBackupTarget"`
+		DefaultDeploymentTarget   bool                   `json:"defaultDeploymentTarget"`
+		DefaultVirtualImageTarget bool                   `json:"defaultVirtualImageTarget"`
+		RetentionPolicyType       string                 `json:"retentionPolicyType"`
+		RetentionPolicyDays       interface{}            `json:"retentionPolicyDays"`
+		Config                    map[string]interface{} `json:"config"`
+	} `json:"fileShare"`
+}
@@ -86,7 +86,7 @@ func resourceFileTemplate() *schema.Resource {
 }
 
 func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -133,7 +133,7 @@ func resourceFileTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -183,7 +183,7 @@ func resourceFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -226,7 +226,7 @@ func resourceFileTemplateUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFileTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -541,7 +541,7 @@ func resourceForm() *schema.Resource {
 }
 
 func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -812,7 +812,7 @@ func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -1001,7 +1001,7 @@ func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interfac
 }
 
 func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	// create the payload for option types not in a field group
@@ -1264,7 +1264,7 @@ func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceFormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -70,7 +70,7 @@ func resourceHelmAppBlueprint() *schema.Resource {
 }
 
 func resourceHelmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -126,7 +126,7 @@ func resourceHelmAppBlueprintCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -175,7 +175,7 @@ func resourceHelmAppBlueprintRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	blueprint_type := "helm"
@@ -226,7 +226,7 @@ func resourceHelmAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
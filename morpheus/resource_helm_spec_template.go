@@ -70,7 +70,7 @@ func resourceHelmSpecTemplate() *schema.Resource {
 }
 
 func resourceHelmSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -124,7 +124,7 @@ func resourceHelmSpecTemplateCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -182,7 +182,7 @@ func resourceHelmSpecTemplateRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHelmSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -231,7 +231,7 @@ func resourceHelmSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceHelmSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
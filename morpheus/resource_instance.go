@@ -0,0 +1,696 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInstance() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance resource for any cloud and provision type.",
+		CreateContext: resourceInstanceCreate,
+		ReadContext:   resourceInstanceRead,
+		UpdateContext: resourceInstanceUpdate,
+		DeleteContext: resourceInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the instance",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the instance",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The user friendly description of the instance",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"cloud_id": {
+				Description: "The ID of the cloud associated with the instance",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"group_id": {
+				Description: "The ID of the group associated with the instance",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"instance_type_code": {
+				Description: "The code of the type of instance to provision",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"instance_layout_id": {
+				Description: "The layout to provision the instance from",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"plan_id": {
+				Description: "The service plan associated with the instance, changing the plan resizes the instance",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"resource_pool_id": {
+				Description: "The ID of the resource pool to provision the instance to",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Description: "The environment to assign the instance to",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeList,
+				Description: "The list of labels to add to the instance",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"tags": {
+				Description: "Tags to assign to the instance",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_options": {
+				Description: "Custom options to pass to the instance",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"config": {
+				Description:      "The provision type specific configuration of the instance, sent as the config of the instance payload. Values that are valid JSON objects or arrays are sent decoded, changing the config replaces the instance",
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ForceNew:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"volumes": {
+				Description: "The instance volumes to create, volumes can be added, grown or removed by resizing the instance. Existing volumes are matched on the root flag and name, so volume names must be unique and cannot be changed",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"root": {
+							Description: "Whether the volume is the root volume of the instance",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the volume",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"size": {
+							Description: "The size of the volume in GB",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"storage_type": {
+							Description: "The ID of the storage type of the volume",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"datastore_id": {
+							Description: "The ID of the datastore of the volume",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"interfaces": {
+				Description: "The instance network interfaces to create, network interfaces can be added or removed by resizing the instance. Existing network interfaces are matched on their network",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Description: "The network to assign the network interface to",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+						"network_group": {
+							Description: "Whether the network id provided is for a network group or not",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+						"ip_address": {
+							Description: "The static IP address of the network interface",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"ip_mode": {
+							Description: "The IP mode of the network interface",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"network_interface_type_id": {
+							Description: "The network interface type",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": {
+				Description: "The status of the instance",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"connection_info": {
+				Description: "Connection information for the instance, a list - this is returned by the API",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Description: "The IP address to connect to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"port": {
+							Description: "The port to connect to",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the connection protocol",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"power_state": powerStateSchema(),
+			"force_delete": {
+				Description: "Whether to force the deletion of the instance, even if the removal of its resources fails. Defaults to the force_delete argument of the provider",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Description: "Whether to preserve the volumes of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keep_backups": {
+				Description: "Whether to keep the backups of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"release_ips": {
+				Description: "Whether to release the elastic and floating IP addresses of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		},
+		CustomizeDiff: volumeRenamesCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	config := expandInstanceConfig(d.Get("config").(map[string]interface{}))
+
+	// Resource Pool
+	if d.Get("resource_pool_id").(int) != 0 {
+		config["resourcePoolId"] = d.Get("resource_pool_id").(int)
+	}
+
+	// Custom Options
+	if d.Get("custom_options") != nil {
+		customOptionsInput := d.Get("custom_options").(map[string]interface{})
+		customOptions := make(map[string]interface{})
+		for key, value := range customOptionsInput {
+			customOptions[key] = value.(string)
+		}
+		config["customOptions"] = customOptions
+	}
+
+	instancePayload := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"type": d.Get("instance_type_code").(string),
+		"site": map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		},
+		"plan": map[string]interface{}{
+			"id": d.Get("plan_id").(int),
+		},
+		"layout": map[string]interface{}{
+			"id": d.Get("instance_layout_id").(int),
+		},
+	}
+
+	// Description
+	if d.Get("description") != nil {
+		instancePayload["description"] = d.Get("description").(string)
+	}
+
+	// Environment
+	if d.Get("environment") != nil {
+		instancePayload["instanceContext"] = d.Get("environment").(string)
+	}
+
+	payload := map[string]interface{}{
+		"zoneId":   d.Get("cloud_id").(int),
+		"instance": instancePayload,
+		"config":   config,
+	}
+
+	// tags
+	if d.Get("tags") != nil {
+		tagsInput := d.Get("tags").(map[string]interface{})
+		var tags []map[string]interface{}
+		for key, value := range tagsInput {
+			tag := make(map[string]interface{})
+			tag["name"] = key
+			tag["value"] = value.(string)
+			tags = append(tags, tag)
+		}
+		payload["tags"] = tags
+	}
+
+	// Labels
+	if d.Get("labels") != nil {
+		payload["labels"] = d.Get("labels")
+	}
+
+	// Network Interfaces
+	if interfaces := d.Get("interfaces").([]interface{}); len(interfaces) > 0 {
+		payload["networkInterfaces"] = parseNetworkInterfaces(interfaces)
+	}
+
+	// Volumes
+	if volumes := d.Get("volumes").([]interface{}); len(volumes) > 0 {
+		payload["volumes"] = parseStorageVolumes(volumes)
+	}
+
+	req := &morpheus.Request{Body: payload}
+	resp, err := client.CreateInstance(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.CreateInstanceResult)
+	instance := result.Instance
+	instanceStatus := "provisioning"
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"provisioning", "starting", "stopping", "pending"},
+		Target:  []string{"running", "failed", "warning", "denied", "cancelled", "suspended"},
+		Refresh: func() (interface{}, string, error) {
+			instanceDetails, err := client.GetInstance(instance.ID, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := instanceDetails.Result.(*morpheus.GetInstanceResult)
+			instance := result.Instance
+			instanceStatus = instance.Status
+			return result, instance.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		MinTimeout:   1 * time.Minute,
+		Delay:        pollDelay(meta, 2*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error creating instance: %s", err)
+	}
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(instance.ID))
+
+	// Stop or suspend the instance once it is provisioned. The id is set
+	// first so the instance is kept in state if this fails
+	if powerState, ok := d.GetOk("power_state"); ok && instanceStatus != "failed" {
+		if err := setInstancePowerState(ctx, meta, instance.ID, instanceStatus, powerState.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error setting power state of instance: %s", err)
+		}
+	}
+
+	resourceInstanceRead(ctx, d, meta)
+
+	// Fail the instance deployment if the
+	// instance status is in a failed state
+	if instanceStatus == "failed" {
+		return diag.Errorf("error creating instance: failed to create server")
+	}
+	return diags
+}
+
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetInstanceResult)
+	instance := result.Instance
+	if instance == nil {
+		return diag.Errorf("Instance not found in response data.") // should not happen
+	}
+
+	d.SetId(int64ToString(instance.ID))
+	d.Set("name", instance.Name)
+	d.Set("description", instance.Description)
+	d.Set("cloud_id", instance.Cloud.ID)
+	d.Set("group_id", instance.Group.ID)
+	d.Set("instance_type_code", instance.InstanceType.Code)
+	d.Set("instance_layout_id", instance.Layout.ID)
+	d.Set("plan_id", instance.Plan.ID)
+	if d.Get("resource_pool_id").(int) != 0 {
+		d.Set("resource_pool_id", apiInt64(instance.Config["resourcePoolId"]))
+	}
+	d.Set("environment", instance.Environment)
+	d.Set("labels", instance.Labels)
+	d.Set("status", instance.Status)
+	setPowerStateFromStatus(d, instance.Status)
+
+	// Tags
+	tags := make(map[string]interface{})
+	for _, tag := range instance.Tags {
+		tags[tag.Name] = tag.Value
+	}
+	d.Set("tags", tags)
+
+	// Only the configured custom options are read back, the API returns
+	// the custom options of the instance type and layout as well
+	customOptions := make(map[string]interface{})
+	apiCustomOptions, _ := instance.Config["customOptions"].(map[string]interface{})
+	for key, value := range d.Get("custom_options").(map[string]interface{}) {
+		customOptions[key] = value
+		if apiValue, ok := apiCustomOptions[key]; ok && apiValue != nil {
+			customOptions[key] = flattenInstanceConfigValue(apiValue)
+		}
+	}
+	d.Set("custom_options", customOptions)
+
+	// Only the configured keys are read back, the API returns many
+	// more settings in the config of an instance
+	config := make(map[string]interface{})
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		config[key] = value
+		if apiValue, ok := instance.Config[key]; ok && apiValue != nil {
+			config[key] = flattenInstanceConfigValue(apiValue)
+		}
+	}
+	d.Set("config", config)
+
+	d.Set("volumes", orderVolumes(flattenInstanceVolumes(instance.Volumes), d.Get("volumes").([]interface{})))
+	d.Set("interfaces", orderNetworkInterfaces(flattenInstanceNetworkInterfaces(instance.Interfaces), d.Get("interfaces").([]interface{})))
+
+	var connectionInfo []map[string]interface{}
+	// Iterate over the array of connection info
+	for i := 0; i < len(instance.ConnectionInfo); i++ {
+		row := make(map[string]interface{})
+		connection := instance.ConnectionInfo[i]
+		row["ip"] = connection.Ip
+		row["port"] = connection.Port
+		row["name"] = connection.Name
+		connectionInfo = append(connectionInfo, row)
+	}
+	d.Set("connection_info", connectionInfo)
+
+	return diags
+}
+
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	if d.HasChanges("name", "description", "environment", "labels", "tags", "custom_options") {
+		// Tags
+		var tags []map[string]interface{}
+		tagsInput := d.Get("tags").(map[string]interface{})
+		for key, value := range tagsInput {
+			tag := make(map[string]interface{})
+			tag["name"] = key
+			tag["value"] = value.(string)
+			tags = append(tags, tag)
+		}
+
+		// Custom Options
+		customOptions := make(map[string]interface{})
+		for key, value := range d.Get("custom_options").(map[string]interface{}) {
+			customOptions[key] = value.(string)
+		}
+
+		instancePayload := map[string]interface{}{
+			"name":            d.Get("name").(string),
+			"description":     d.Get("description").(string),
+			"labels":          d.Get("labels"),
+			"tags":            tags,
+			"instanceContext": d.Get("environment"),
+			"config": map[string]interface{}{
+				"customOptions": customOptions,
+			},
+		}
+		payload := map[string]interface{}{
+			"instance": instancePayload,
+		}
+		req := &morpheus.Request{Body: payload}
+		resp, err := client.UpdateInstance(toInt64(id), req)
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	// Resize the instance when the plan, volumes or interfaces have changed
+	if d.HasChanges("plan_id", "volumes", "interfaces") {
+		instanceGetResp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", instanceGetResp, err)
+			return diag.FromErr(err)
+		}
+		fetchInstance := instanceGetResp.Result.(*morpheus.GetInstanceResult).Instance
+
+		resizePayload := map[string]interface{}{
+			"instance": map[string]interface{}{
+				"plan": map[string]interface{}{
+					"id": d.Get("plan_id"),
+				},
+			},
+		}
+		// The volumes and interfaces of the instance are left unchanged
+		// when none are configured
+		if volumes := d.Get("volumes").([]interface{}); len(volumes) > 0 {
+			storageVolumes := parseStorageVolumes(volumes)
+			resizeVolumes(storageVolumes, fetchInstance.Volumes)
+			resizePayload["volumes"] = storageVolumes
+			resizePayload["deleteOriginalVolumes"] = true
+		}
+		if interfaces := d.Get("interfaces").([]interface{}); len(interfaces) > 0 {
+			networkInterfaces := parseNetworkInterfaces(interfaces)
+			resizeNetworkInterfaces(networkInterfaces, fetchInstance.Interfaces)
+			resizePayload["networkInterfaces"] = networkInterfaces
+		}
+		if err := resizeInstance(ctx, d, meta, toInt64(id), resizePayload); err != nil {
+			return diag.Errorf("error resizing instance: %s", err)
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := setInstancePowerState(ctx, meta, toInt64(id), "", d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating power state of instance: %s", err)
+		}
+	}
+
+	return resourceInstanceRead(ctx, d, meta)
+}
+
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{
+		QueryParams: map[string]string{},
+	}
+	deleteQueryParams(d, meta, req.QueryParams)
+	resp, err := client.DeleteInstance(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// Wait for the instance to be removed so that an instance with the
+	// same name can be created straight away
+	stateConf := retry.StateChangeConf{
+		Delay:        pollDelay(meta, 1*time.Second),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: pollInterval(meta, 10*time.Second),
+		MinTimeout:   1 * time.Second,
+		Pending:      []string{"200"},
+		Target:       []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err = client.GetInstance(toInt64(id), &morpheus.Request{})
+			if err != nil {
+				if resp != nil {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return "", "", err
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// expandInstanceConfig returns the config payload of an instance from the
+// config argument. Values holding a JSON object or array are decoded so
+// that nested settings can be passed through the flat map.
+func expandInstanceConfig(input map[string]interface{}) map[string]interface{} {
+	config := make(map[string]interface{})
+	for key, value := range input {
+		s := value.(string)
+		if len(s) > 0 && (s[0] == '{' || s[0] == '[') {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(s), &decoded); err == nil {
+				config[key] = decoded
+				continue
+			}
+		}
+		config[key] = s
+	}
+	return config
+}
+
+// flattenInstanceConfigValue returns the string form of a config value
+// returned by the API, the reverse of expandInstanceConfig.
+func flattenInstanceConfigValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// flattenInstanceVolumes returns the volumes of an instance as returned by
+// the API in the form of the volumes argument.
+func flattenInstanceVolumes(instanceVolumes Volumes) []map[string]interface{} {
+	var volumes []map[string]interface{}
+	for _, volume := range instanceVolumes {
+		volumes = append(volumes, map[string]interface{}{
+			"root":         volume.RootVolume == true,
+			"name":         volume.Name,
+			"size":         apiInt64(volume.Size),
+			"storage_type": apiInt64(volume.StorageType),
+			"datastore_id": apiInt64(volume.DatastoreId),
+		})
+	}
+	return volumes
+}
+
+// flattenInstanceNetworkInterfaces returns the network interfaces of an
+// instance as returned by the API in the form of the interfaces argument.
+func flattenInstanceNetworkInterfaces(instanceInterfaces []morpheus.NetworkInterface) []map[string]interface{} {
+	var networkInterfaces []map[string]interface{}
+	for _, networkInterface := range instanceInterfaces {
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"network_id":                int(networkInterface.Network.ID),
+			"network_group":             networkInterface.Network.Group,
+			"ip_address":                networkInterface.IpAddress,
+			"ip_mode":                   networkInterface.IpMode,
+			"network_interface_type_id": networkInterface.NetworkInterfaceTypeId,
+		})
+	}
+	return networkInterfaces
+}
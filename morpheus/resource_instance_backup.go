@@ -0,0 +1,355 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInstanceBackup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance backup resource, which configures the backup of an instance and attaches it to a backup job",
+		CreateContext: resourceInstanceBackupCreate,
+		ReadContext:   resourceInstanceBackupRead,
+		UpdateContext: resourceInstanceBackupUpdate,
+		DeleteContext: resourceInstanceBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the instance backup",
+				Computed:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the instance to back up",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the instance backup",
+				Required:    true,
+			},
+			"job_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the backup job that schedules the backup",
+				Optional:    true,
+			},
+			"backup_type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup type, such as vmwareSnapshot or awsSnapshot, defaults to the backup type of the instance's provisioning type",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"storage_provider_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage bucket or file share the backups are stored in, overrides the storage provider of the backup job",
+				Optional:    true,
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of backups to keep, overrides the retention count of the backup job",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the backup type specific options, such as {\"copyToStore\": true} for snapshot backups",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instance backup is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"run_on_create": {
+				Type:        schema.TypeBool,
+				Description: "Whether to run a first backup when the instance backup is created and wait for it to succeed",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInstanceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	backup, err := parseInstanceBackupPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backup["locationType"] = "instance"
+	backup["instanceId"] = d.Get("instance_id").(int)
+	if backupTypeCode, ok := d.GetOk("backup_type_code"); ok {
+		backup["backupType"] = map[string]interface{}{"code": backupTypeCode.(string)}
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   "/api/backups",
+		Body: map[string]interface{}{
+			"backup": backup,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var backupPayload InstanceBackupPayload
+	if err := json.Unmarshal(resp.Body, &backupPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backupPayload.Backup.ID))
+
+	if d.Get("run_on_create").(bool) {
+		if err := runInstanceBackup(ctx, meta, backupPayload.Backup.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error running instance backup: %s", err)
+		}
+	}
+
+	resourceInstanceBackupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceInstanceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/backups/%s", id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var backupPayload InstanceBackupPayload
+	if err := json.Unmarshal(resp.Body, &backupPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	backup := backupPayload.Backup
+	d.SetId(int64ToString(backup.ID))
+	if backup.Instance.ID != 0 {
+		d.Set("instance_id", backup.Instance.ID)
+	}
+	d.Set("name", backup.Name)
+	d.Set("job_id", backup.Job.ID)
+	if backup.BackupType.Code != "" {
+		d.Set("backup_type_code", backup.BackupType.Code)
+	}
+	d.Set("storage_provider_id", backup.StorageProvider.ID)
+	d.Set("retention_count", apiInt64(backup.RetentionCount))
+	d.Set("enabled", backup.Enabled)
+	return diags
+}
+
+func resourceInstanceBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	backup, err := parseInstanceBackupPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/backups/%s", id),
+		Body: map[string]interface{}{
+			"backup": backup,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceInstanceBackupRead(ctx, d, meta)
+}
+
+func resourceInstanceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/backups/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseInstanceBackupPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	backup := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"enabled": d.Get("enabled").(bool),
+	}
+	if retentionCount, ok := d.GetOk("retention_count"); ok {
+		backup["retentionCount"] = retentionCount.(int)
+	}
+	// a nil id detaches the backup from its job or storage provider
+	backup["job"] = map[string]interface{}{"id": nil}
+	if jobID, ok := d.GetOk("job_id"); ok {
+		backup["job"] = map[string]interface{}{"id": jobID.(int)}
+	}
+	backup["storageProvider"] = map[string]interface{}{"id": nil}
+	if storageProviderID, ok := d.GetOk("storage_provider_id"); ok {
+		backup["storageProvider"] = map[string]interface{}{"id": storageProviderID.(int)}
+	}
+	if config, ok := d.GetOk("config"); ok {
+		var configPayload map[string]interface{}
+		if err := json.Unmarshal([]byte(config.(string)), &configPayload); err != nil {
+			return nil, err
+		}
+		backup["config"] = configPayload
+	}
+	return backup, nil
+}
+
+// runInstanceBackup executes a backup and waits for its result to succeed.
+func runInstanceBackup(ctx context.Context, meta interface{}, id int64, timeout time.Duration) error {
+	client := meta.(*providerMeta).client
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/backups/%d/execute", id),
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", "START_REQUESTED", "INITIALIZING", "IN_PROGRESS"},
+		Target:  []string{"SUCCEEDED", "FAILED", "CANCELLED", "CANCEL_REQUESTED"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Execute(&morpheus.Request{
+				Method: "GET",
+				Path:   "/api/backups/results",
+				QueryParams: map[string]string{
+					"backupId":  int64ToString(id),
+					"max":       "1",
+					"sort":      "dateCreated",
+					"direction": "desc",
+				},
+			})
+			if err != nil {
+				return "", "", err
+			}
+			var results BackupResultsPayload
+			if err := json.Unmarshal(resp.Body, &results); err != nil {
+				return "", "", err
+			}
+			// the result is not listed until the backup has started
+			if len(results.Results) == 0 {
+				return results, "", nil
+			}
+			return results, results.Results[0].Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   30 * time.Second,
+		Delay:        pollDelay(meta, 30*time.Second),
+		PollInterval: pollInterval(meta, 30*time.Second),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+	backupResult := result.(BackupResultsPayload).Results[0]
+	if backupResult.Status != "SUCCEEDED" {
+		if backupResult.ErrorMessage != "" {
+			return fmt.Errorf("backup %d is %s: %s", id, backupResult.Status, backupResult.ErrorMessage)
+		}
+		return fmt.Errorf("backup %d is %s", id, backupResult.Status)
+	}
+	return nil
+}
+
+type InstanceBackupPayload struct {
+	Backup struct {
+		ID             int64       `json:"id"`
+		Name           string      `json:"name"`
+		RetentionCount interface{} `json:"retentionCount"`
+		Enabled        bool        `json:"enabled"`
+		Instance       struct {
+			ID int64 `json:"id"`
+		} `json:"instance"`
+		Job struct {
+			ID int64 `json:"id"`
+		} `json:"job"`
+		BackupType struct {
+			Code string `json:"code"`
+		} `json:"backupType"`
+		StorageProvider struct {
+			ID int64 `json:"id"`
+		} `json:"storageProvider"`
+	} `json:"backup"`
+}
+
+type BackupResultsPayload struct {
+	Results []struct {
+		ID           int64  `json:"id"`
+		Status       string `json:"status"`
+		ErrorMessage string `json:"errorMessage"`
+	} `json:"results"`
+}
@@ -122,7 +122,7 @@ func resourceInstanceCatalogItem() *schema.Resource {
 }
 
 func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -205,7 +205,7 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -269,7 +269,7 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	catalogItem := make(map[string]interface{})
@@ -344,7 +344,7 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceInstanceCatalogItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -186,7 +186,7 @@ func resourceInstanceLayout() *schema.Resource {
 }
 
 func resourceInstanceLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -258,7 +258,7 @@ func resourceInstanceLayoutCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -385,7 +385,7 @@ func resourceInstanceLayoutRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	instanceLayout := make(map[string]interface{})
@@ -453,7 +453,7 @@ func resourceInstanceLayoutUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceInstanceLayoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -114,7 +114,7 @@ func resourceInstanceNamePolicy() *schema.Resource {
 }
 
 func resourceInstanceNamePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -192,7 +192,7 @@ func resourceInstanceNamePolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -265,7 +265,7 @@ func resourceInstanceNamePolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -340,7 +340,7 @@ func resourceInstanceNamePolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceInstanceNamePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
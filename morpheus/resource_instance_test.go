@@ -0,0 +1,174 @@
+package morpheus
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMorpheusInstance_basic(t *testing.T) {
+	t.Setenv("MORPHEUS_API_POLL_DELAY", "1ms")
+	t.Setenv("MORPHEUS_API_POLL_INTERVAL", "1ms")
+	srv := newFakeMorpheusServer(t)
+	srv.handle("POST", "/api/instances", func(body interface{}) (int, interface{}) {
+		payload := body.(map[string]interface{})
+		request := payload["instance"].(map[string]interface{})
+		config := payload["config"].(map[string]interface{})
+		// The API returns the custom options of the layout as well
+		config["customOptions"].(map[string]interface{})["managedBy"] = "morpheus"
+		volumes := payload["volumes"].([]interface{})
+		for i, volume := range volumes {
+			volume.(map[string]interface{})["id"] = 10 + i
+		}
+		instance := map[string]interface{}{
+			"name":         request["name"],
+			"status":       "running",
+			"cloud":        map[string]interface{}{"id": payload["zoneId"]},
+			"group":        request["site"],
+			"instanceType": map[string]interface{}{"code": request["type"]},
+			"layout":       request["layout"],
+			"plan":         request["plan"],
+			"config":       config,
+			"volumes":      volumes,
+		}
+		id := srv.store("/api/instances", "instance", instance)
+		return http.StatusOK, map[string]interface{}{
+			"success":  true,
+			"instance": srv.collections["/api/instances"].objects[id],
+		}
+	})
+	srv.handle("PUT", "/api/instances/1/resize", func(body interface{}) (int, interface{}) {
+		payload := body.(map[string]interface{})
+		instance := srv.collections["/api/instances"].objects[1]
+		instance["plan"] = payload["instance"].(map[string]interface{})["plan"]
+		instance["volumes"] = payload["volumes"]
+		return http.StatusOK, map[string]interface{}{
+			"success":  true,
+			"instance": instance,
+		}
+	})
+	resourceName := "morpheus_instance.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_instance"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusInstanceConfig(1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName, "plan_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_options.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_options.region", "east"),
+					resource.TestCheckResourceAttr(resourceName, "config.availabilityZone", "us-east-1a"),
+					resource.TestCheckResourceAttr(resourceName, "volumes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "volumes.1.name", "data"),
+					resource.TestCheckResourceAttr(resourceName, "volumes.1.size", "10"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusInstanceConfig(2, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "plan_id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "volumes.1.size", "20"),
+					testAccCheckMorpheusInstanceResized(srv),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckMorpheusInstanceResized checks that the instance was resized
+// in place, keeping the ID of the existing data volume.
+func testAccCheckMorpheusInstanceResized(srv *fakeMorpheusServer) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if creates := srv.requests("POST", "/api/instances"); len(creates) != 1 {
+			return fmt.Errorf("expected the instance to be created once, got %d creates", len(creates))
+		}
+		resizes := srv.requests("PUT", "/api/instances/1/resize")
+		if len(resizes) != 1 {
+			return fmt.Errorf("expected the instance to be resized once, got %d resizes", len(resizes))
+		}
+		volumes := resizes[0].RequestBody.(map[string]interface{})["volumes"].([]interface{})
+		if id := volumes[1].(map[string]interface{})["id"]; id != float64(11) {
+			return fmt.Errorf("expected the data volume to keep ID 11, got %v", id)
+		}
+		return nil
+	}
+}
+
+func testAccMorpheusInstanceConfig(planID, dataSize int) string {
+	return fmt.Sprintf(`
+resource "morpheus_instance" "tfacc" {
+  name               = "tfacc"
+  cloud_id           = 1
+  group_id           = 2
+  instance_type_code = "ubuntu"
+  instance_layout_id = 3
+  plan_id            = %d
+
+  custom_options = {
+    region = "east"
+  }
+
+  config = {
+    availabilityZone = "us-east-1a"
+    securityGroups   = "[{ \"id\": \"sg-1\" }]"
+  }
+
+  volumes {
+    root = true
+    name = "root"
+    size = 20
+  }
+
+  volumes {
+    root = false
+    name = "data"
+    size = %d
+  }
+}
+`, planID, dataSize)
+}
+
+func TestExpandInstanceConfig(t *testing.T) {
+	config := expandInstanceConfig(map[string]interface{}{
+		"availabilityZone": "1",
+		"securityGroups":   `[{"id":"sg-1"}]`,
+		"notJson":          "{not json",
+	})
+
+	expected := map[string]interface{}{
+		"availabilityZone": "1",
+		"securityGroups": []interface{}{
+			map[string]interface{}{"id": "sg-1"},
+		},
+		"notJson": "{not json",
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, config)
+	}
+}
+
+func TestFlattenInstanceConfigValue(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected string
+	}{
+		{"1", "1"},
+		{true, "true"},
+		{float64(42), "42"},
+		{[]interface{}{map[string]interface{}{"id": "sg-1"}}, `[{"id":"sg-1"}]`},
+	}
+	for _, c := range cases {
+		if got := flattenInstanceConfigValue(c.value); got != c.expected {
+			t.Errorf("expected %q for %#v, got %q", c.expected, c.value, got)
+		}
+	}
+}
@@ -57,7 +57,7 @@ func resourceIPv4IPPool() *schema.Resource {
 }
 
 func resourceIPv4IPPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -87,7 +87,7 @@ func resourceIPv4IPPoolCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIPv4IPPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -151,7 +151,7 @@ func resourceIPv4IPPoolRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceIPv4IPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -177,7 +177,7 @@ func resourceIPv4IPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIPv4IPPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -18,6 +18,7 @@ func resourceKeyPair() *schema.Resource {
 		Description:   "Provides a Morpheus key pair resource.",
 		CreateContext: resourceKeyPairCreate,
 		ReadContext:   resourceKeyPairRead,
+		UpdateContext: resourceKeyPairUpdate,
 		DeleteContext: resourceKeyPairDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -57,6 +58,21 @@ func resourceKeyPair() *schema.Resource {
 					return strings.EqualFold(old, sha256_hash)
 				},
 			},
+			"private_key_wo": {
+				Type:          schema.TypeString,
+				Description:   "The private key of the key pair, which is not stored in state. Requires Terraform 1.11 or later",
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+				RequiredWith:  []string{"private_key_wo_version"},
+			},
+			"private_key_wo_version": {
+				Type:        schema.TypeInt,
+				Description: "The version of the write-only private key, which must be changed to replace the key pair with a new private key",
+				ForceNew:    true,
+				Optional:    true,
+			},
 			"passphrase": {
 				Type:        schema.TypeString,
 				Description: "The passphrase for the private key of the key pair",
@@ -71,7 +87,7 @@ func resourceKeyPair() *schema.Resource {
 	}
 }
 func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -80,6 +96,9 @@ func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta int
 	keyPairPayload["name"] = d.Get("name").(string)
 	keyPairPayload["publicKey"] = d.Get("public_key").(string)
 	keyPairPayload["privateKey"] = d.Get("private_key").(string)
+	if _, ok := d.GetOk("private_key_wo_version"); ok {
+		keyPairPayload["privateKey"] = writeOnlyString(d, "private_key_wo")
+	}
 	keyPairPayload["passphrase"] = d.Get("passphrase").(string)
 
 	req := &morpheus.Request{
@@ -105,7 +124,7 @@ func resourceKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -139,15 +158,25 @@ func resourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.SetId(int64ToString(keyPair.ID))
 		d.Set("name", keyPair.Name)
 		d.Set("public_key", keyPair.PublicKey)
-		d.Set("private_key", keyPair.PrivateKeyHash)
+		if _, ok := d.GetOk("private_key_wo_version"); !ok {
+			d.Set("private_key", keyPair.PrivateKeyHash)
+		}
 	} else {
 		return diag.Errorf("Key pair not found in response data.") // should not happen
 	}
 	return diags
 }
 
+// resourceKeyPairUpdate only exists because the write-only private key
+// cannot force a new key pair. The API cannot change the key material of a
+// key pair, so a new private key is only written by changing the ForceNew
+// private_key_wo_version, which replaces the key pair.
+func resourceKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Errorf("the key material of key pair %s cannot be changed in place, change private_key_wo_version to replace the key pair", d.Id())
+}
+
 func resourceKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -70,7 +70,7 @@ func resourceKubernetesSpecTemplate() *schema.Resource {
 }
 
 func resourceKubernetesSpecTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -124,7 +124,7 @@ func resourceKubernetesSpecTemplateCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesSpecTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -182,7 +182,7 @@ func resourceKubernetesSpecTemplateRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceKubernetesSpecTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -231,7 +231,7 @@ func resourceKubernetesSpecTemplateUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKubernetesSpecTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -38,7 +38,7 @@ func resourceLicense() *schema.Resource {
 }
 
 func resourceLicenseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -66,7 +66,7 @@ func resourceLicenseCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -97,7 +97,7 @@ func resourceLicenseRead(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceLicenseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
@@ -121,7 +121,7 @@ func resourceLicenseUpdate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceLicenseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
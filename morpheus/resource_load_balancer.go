@@ -0,0 +1,300 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer resource, which integrates a load balancer such as an F5 BIG-IP, NSX or AVI load balancer with Morpheus",
+		CreateContext: resourceLoadBalancerCreate,
+		ReadContext:   resourceLoadBalancerRead,
+		UpdateContext: resourceLoadBalancerUpdate,
+		DeleteContext: resourceLoadBalancerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer",
+				Required:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the load balancer type, such as f5",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud of the load balancer, required by load balancer types that are managed through a cloud",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or IP address of the load balancer API",
+				Optional:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port of the load balancer API",
+				Optional:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account used to manage the load balancer",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to manage the load balancer",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the type specific settings of the load balancer",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the load balancer is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the load balancer is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	loadBalancer, err := parseLoadBalancerPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	loadBalancer["type"] = map[string]interface{}{
+		"code": d.Get("type_code").(string),
+	}
+	if cloudID, ok := d.GetOk("cloud_id"); ok {
+		loadBalancer["cloud"] = map[string]interface{}{
+			"id": cloudID.(int),
+		}
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   "/api/load-balancers",
+		Body: map[string]interface{}{
+			"loadBalancer": loadBalancer,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var loadBalancerPayload LoadBalancerPayload
+	if err := json.Unmarshal(resp.Body, &loadBalancerPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(loadBalancerPayload.LoadBalancer.ID))
+
+	resourceLoadBalancerRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/load-balancers/%s", id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var loadBalancerPayload LoadBalancerPayload
+	if err := json.Unmarshal(resp.Body, &loadBalancerPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	loadBalancer := loadBalancerPayload.LoadBalancer
+	d.SetId(int64ToString(loadBalancer.ID))
+	d.Set("name", loadBalancer.Name)
+	d.Set("type_code", loadBalancer.Type.Code)
+	d.Set("description", loadBalancer.Description)
+	if loadBalancer.Cloud.ID != 0 {
+		d.Set("cloud_id", loadBalancer.Cloud.ID)
+	}
+	d.Set("host", loadBalancer.SshHost)
+	d.Set("port", apiInt64(loadBalancer.ApiPort))
+	d.Set("username", loadBalancer.SshUsername)
+	d.Set("visibility", loadBalancer.Visibility)
+	d.Set("enabled", loadBalancer.Enabled)
+	d.Set("status", loadBalancer.Status)
+	return diags
+}
+
+func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	loadBalancer, err := parseLoadBalancerPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/load-balancers/%s", id),
+		Body: map[string]interface{}{
+			"loadBalancer": loadBalancer,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/load-balancers/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseLoadBalancerPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := expandLoadBalancerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"sshHost":     d.Get("host").(string),
+		"sshUsername": d.Get("username").(string),
+		"visibility":  d.Get("visibility").(string),
+		"enabled":     d.Get("enabled").(bool),
+		"config":      config,
+	}
+	if port, ok := d.GetOk("port"); ok {
+		loadBalancer["apiPort"] = port.(int)
+	}
+	if password, ok := d.GetOk("password"); ok {
+		loadBalancer["sshPassword"] = password.(string)
+	}
+	return loadBalancer, nil
+}
+
+// expandLoadBalancerConfig decodes the JSON config argument of the load
+// balancer resources.
+func expandLoadBalancerConfig(d *schema.ResourceData) (map[string]interface{}, error) {
+	config := make(map[string]interface{})
+	if v := d.Get("config").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &config); err != nil {
+			return nil, fmt.Errorf("error decoding config: %s", err)
+		}
+	}
+	return config, nil
+}
+
+type LoadBalancerPayload struct {
+	LoadBalancer struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Type        struct {
+			Code string `json:"code"`
+		} `json:"type"`
+		Cloud struct {
+			ID int64 `json:"id"`
+		} `json:"cloud"`
+		SshHost     string      `json:"sshHost"`
+		ApiPort     interface{} `json:"apiPort"`
+		SshUsername string      `json:"sshUsername"`
+		Visibility  string      `json:"visibility"`
+		Enabled     bool        `json:"enabled"`
+		Status      string      `json:"status"`
+	} `json:"loadBalancer"`
+}
@@ -0,0 +1,245 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerMonitor() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer health monitor resource",
+		CreateContext: resourceLoadBalancerMonitorCreate,
+		ReadContext:   resourceLoadBalancerMonitorRead,
+		UpdateContext: resourceLoadBalancerMonitorUpdate,
+		DeleteContext: resourceLoadBalancerMonitorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer health monitor",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the load balancer to create the health monitor in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer health monitor",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer health monitor",
+				Optional:    true,
+			},
+			"monitor_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the load balancer health monitor, such as http, https, tcp or icmp",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds between health checks",
+				Optional:    true,
+				Default:     5,
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds after which a member that failed its health checks is marked down",
+				Optional:    true,
+				Default:     16,
+			},
+			"send_data": {
+				Type:        schema.TypeString,
+				Description: "The request sent to the members to check their health, such as GET /health",
+				Optional:    true,
+			},
+			"receive_data": {
+				Type:        schema.TypeString,
+				Description: "The response expected from a healthy member",
+				Optional:    true,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the type specific settings of the load balancer health monitor",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithParentID("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	monitor, err := parseLoadBalancerMonitorPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	monitor["monitorType"] = d.Get("monitor_type").(string)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/monitors", d.Get("load_balancer_id").(int)),
+		Body: map[string]interface{}{
+			"loadBalancerMonitor": monitor,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var monitorPayload LoadBalancerMonitorPayload
+	if err := json.Unmarshal(resp.Body, &monitorPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(monitorPayload.LoadBalancerMonitor.ID))
+
+	resourceLoadBalancerMonitorRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/monitors/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var monitorPayload LoadBalancerMonitorPayload
+	if err := json.Unmarshal(resp.Body, &monitorPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	monitor := monitorPayload.LoadBalancerMonitor
+	d.SetId(int64ToString(monitor.ID))
+	d.Set("name", monitor.Name)
+	d.Set("description", monitor.Description)
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("interval", apiInt64(monitor.MonitorInterval))
+	d.Set("timeout", apiInt64(monitor.MonitorTimeout))
+	d.Set("send_data", monitor.SendData)
+	d.Set("receive_data", monitor.ReceiveData)
+	return diags
+}
+
+func resourceLoadBalancerMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	monitor, err := parseLoadBalancerMonitorPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/monitors/%s", d.Get("load_balancer_id").(int), id),
+		Body: map[string]interface{}{
+			"loadBalancerMonitor": monitor,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceLoadBalancerMonitorRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/monitors/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseLoadBalancerMonitorPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := expandLoadBalancerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"monitorInterval": d.Get("interval").(int),
+		"monitorTimeout":  d.Get("timeout").(int),
+		"sendData":        d.Get("send_data").(string),
+		"receiveData":     d.Get("receive_data").(string),
+		"config":          config,
+	}, nil
+}
+
+type LoadBalancerMonitorPayload struct {
+	LoadBalancerMonitor struct {
+		ID              int64       `json:"id"`
+		Name            string      `json:"name"`
+		Description     string      `json:"description"`
+		MonitorType     string      `json:"monitorType"`
+		MonitorInterval interface{} `json:"monitorInterval"`
+		MonitorTimeout  interface{} `json:"monitorTimeout"`
+		SendData        string      `json:"sendData"`
+		ReceiveData     string      `json:"receiveData"`
+	} `json:"loadBalancerMonitor"`
+}
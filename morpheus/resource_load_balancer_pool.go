@@ -0,0 +1,290 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer pool resource",
+		CreateContext: resourceLoadBalancerPoolCreate,
+		ReadContext:   resourceLoadBalancerPoolRead,
+		UpdateContext: resourceLoadBalancerPoolUpdate,
+		DeleteContext: resourceLoadBalancerPoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer pool",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the load balancer to create the pool in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer pool",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer pool",
+				Optional:    true,
+			},
+			"balance_mode": {
+				Type:        schema.TypeString,
+				Description: "The balancing method of the load balancer pool, such as roundrobin or leastconnections",
+				Optional:    true,
+				Default:     "roundrobin",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the health monitors of the load balancer pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "The members of the load balancer pool",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the instance to add to the pool, the IP address of the instance is used when ip_address is not set",
+							Optional:    true,
+						},
+						"ip_address": {
+							Type:         schema.TypeString,
+							Description:  "The IP address of the member",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "The port traffic is sent to on the member",
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Description: "The weight of the member when balancing traffic",
+							Optional:    true,
+							Default:     1,
+						},
+					},
+				},
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the type specific settings of the load balancer pool",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithParentID("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	pool, err := parseLoadBalancerPoolPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/pools", d.Get("load_balancer_id").(int)),
+		Body: map[string]interface{}{
+			"loadBalancerPool": pool,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var poolPayload LoadBalancerPoolPayload
+	if err := json.Unmarshal(resp.Body, &poolPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(poolPayload.LoadBalancerPool.ID))
+
+	resourceLoadBalancerPoolRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/pools/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var poolPayload LoadBalancerPoolPayload
+	if err := json.Unmarshal(resp.Body, &poolPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	pool := poolPayload.LoadBalancerPool
+	d.SetId(int64ToString(pool.ID))
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("balance_mode", pool.VipBalance)
+	d.Set("monitor_ids", apiIDs(pool.Monitors))
+	var members []map[string]interface{}
+	for _, member := range pool.Members {
+		members = append(members, map[string]interface{}{
+			"instance_id": member.Instance.ID,
+			"ip_address":  member.IpAddress,
+			"port":        apiInt64(member.Port),
+			"weight":      apiInt64(member.Weight),
+		})
+	}
+	d.Set("member", members)
+	return diags
+}
+
+func resourceLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	pool, err := parseLoadBalancerPoolPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/pools/%s", d.Get("load_balancer_id").(int), id),
+		Body: map[string]interface{}{
+			"loadBalancerPool": pool,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceLoadBalancerPoolRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/pools/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseLoadBalancerPoolPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := expandLoadBalancerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	monitors := make([]int, 0)
+	for _, monitorID := range d.Get("monitor_ids").(*schema.Set).List() {
+		monitors = append(monitors, monitorID.(int))
+	}
+	members := make([]map[string]interface{}, 0)
+	for _, m := range d.Get("member").([]interface{}) {
+		member := m.(map[string]interface{})
+		row := map[string]interface{}{
+			"port":   member["port"].(int),
+			"weight": member["weight"].(int),
+		}
+		if instanceID := member["instance_id"].(int); instanceID != 0 {
+			row["instance"] = map[string]interface{}{"id": instanceID}
+		}
+		if ipAddress := member["ip_address"].(string); ipAddress != "" {
+			row["ipAddress"] = ipAddress
+		}
+		members = append(members, row)
+	}
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"vipBalance":  d.Get("balance_mode").(string),
+		"monitors":    monitors,
+		"members":     members,
+		"config":      config,
+	}, nil
+}
+
+type LoadBalancerPoolPayload struct {
+	LoadBalancerPool struct {
+		ID          int64         `json:"id"`
+		Name        string        `json:"name"`
+		Description string        `json:"description"`
+		VipBalance  string        `json:"vipBalance"`
+		Monitors    []interface{} `json:"monitors"`
+		Members     []struct {
+			Instance struct {
+				ID int64 `json:"id"`
+			} `json:"instance"`
+			IpAddress string      `json:"ipAddress"`
+			Port      interface{} `json:"port"`
+			Weight    interface{} `json:"weight"`
+		} `json:"members"`
+	} `json:"loadBalancerPool"`
+}
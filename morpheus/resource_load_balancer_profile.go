@@ -0,0 +1,211 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerProfile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer profile resource",
+		CreateContext: resourceLoadBalancerProfileCreate,
+		ReadContext:   resourceLoadBalancerProfileRead,
+		UpdateContext: resourceLoadBalancerProfileUpdate,
+		DeleteContext: resourceLoadBalancerProfileDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer profile",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the load balancer to create the profile in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer profile",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer profile",
+				Optional:    true,
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the load balancer profile, such as http, tcp, udp, client-ssl or server-ssl",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the type specific settings of the load balancer profile",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithParentID("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	profile, err := parseLoadBalancerProfilePayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profile["serviceType"] = d.Get("service_type").(string)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/profiles", d.Get("load_balancer_id").(int)),
+		Body: map[string]interface{}{
+			"loadBalancerProfile": profile,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var profilePayload LoadBalancerProfilePayload
+	if err := json.Unmarshal(resp.Body, &profilePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(profilePayload.LoadBalancerProfile.ID))
+
+	resourceLoadBalancerProfileRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/profiles/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var profilePayload LoadBalancerProfilePayload
+	if err := json.Unmarshal(resp.Body, &profilePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	profile := profilePayload.LoadBalancerProfile
+	d.SetId(int64ToString(profile.ID))
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("service_type", profile.ServiceType)
+	return diags
+}
+
+func resourceLoadBalancerProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	profile, err := parseLoadBalancerProfilePayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/profiles/%s", d.Get("load_balancer_id").(int), id),
+		Body: map[string]interface{}{
+			"loadBalancerProfile": profile,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceLoadBalancerProfileRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/profiles/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseLoadBalancerProfilePayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := expandLoadBalancerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"config":      config,
+	}, nil
+}
+
+type LoadBalancerProfilePayload struct {
+	LoadBalancerProfile struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		ServiceType string `json:"serviceType"`
+	} `json:"loadBalancerProfile"`
+}
@@ -0,0 +1,108 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMorpheusLoadBalancer_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_load_balancer.tfacc"
+	poolName := "morpheus_load_balancer_pool.tfacc"
+	virtualServerName := "morpheus_load_balancer_virtual_server.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			srv.checkDestroy("morpheus_load_balancer"),
+			srv.checkDestroy("morpheus_load_balancer_pool"),
+			srv.checkDestroy("morpheus_load_balancer_virtual_server"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusLoadBalancerConfig("first", 8080),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type_code", "f5"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrPair(poolName, "load_balancer_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(poolName, "member.#", "2"),
+					resource.TestCheckResourceAttr(poolName, "member.0.instance_id", "7"),
+					resource.TestCheckResourceAttr(poolName, "member.0.port", "8080"),
+					resource.TestCheckResourceAttr(poolName, "member.1.ip_address", "10.100.0.21"),
+					resource.TestCheckResourceAttr(poolName, "member.1.weight", "2"),
+					resource.TestCheckResourceAttrPair(virtualServerName, "pool_id", poolName, "id"),
+					resource.TestCheckResourceAttr(virtualServerName, "vip_address", "10.100.0.100"),
+					resource.TestCheckResourceAttr(virtualServerName, "vip_port", "443"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusLoadBalancerConfig("second", 9090),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(poolName, "member.0.port", "9090"),
+				),
+			},
+			{
+				ResourceName:      poolName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccLoadBalancerChildImportID(poolName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      virtualServerName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccLoadBalancerChildImportID(virtualServerName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoadBalancerChildImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs := state.RootModule().Resources[resourceName]
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["load_balancer_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccMorpheusLoadBalancerConfig(description string, port int) string {
+	return fmt.Sprintf(`
+resource "morpheus_load_balancer" "tfacc" {
+  name        = "tfacc"
+  type_code   = "f5"
+  description = %q
+  host        = "f5.example.com"
+  port        = 443
+  username    = "admin"
+  password    = "secret"
+}
+
+resource "morpheus_load_balancer_pool" "tfacc" {
+  load_balancer_id = morpheus_load_balancer.tfacc.id
+  name             = "tfacc"
+
+  member {
+    instance_id = 7
+    port        = %d
+  }
+
+  member {
+    ip_address = "10.100.0.21"
+    port       = %d
+    weight     = 2
+  }
+}
+
+resource "morpheus_load_balancer_virtual_server" "tfacc" {
+  load_balancer_id = morpheus_load_balancer.tfacc.id
+  name             = "tfacc"
+  vip_address      = "10.100.0.100"
+  vip_port         = 443
+  pool_id          = morpheus_load_balancer_pool.tfacc.id
+}
+`, description, port, port)
+}
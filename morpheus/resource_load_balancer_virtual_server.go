@@ -0,0 +1,272 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLoadBalancerVirtualServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus load balancer virtual server resource, which exposes a load balancer pool on a virtual IP address (VIP)",
+		CreateContext: resourceLoadBalancerVirtualServerCreate,
+		ReadContext:   resourceLoadBalancerVirtualServerRead,
+		UpdateContext: resourceLoadBalancerVirtualServerUpdate,
+		DeleteContext: resourceLoadBalancerVirtualServerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the load balancer virtual server",
+				Computed:    true,
+			},
+			"load_balancer_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the load balancer to create the virtual server in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer virtual server",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the load balancer virtual server",
+				Optional:    true,
+			},
+			"vip_address": {
+				Type:         schema.TypeString,
+				Description:  "The virtual IP address of the virtual server",
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"vip_port": {
+				Type:         schema.TypeInt,
+				Description:  "The port the virtual server listens on",
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"vip_protocol": {
+				Type:         schema.TypeString,
+				Description:  "The protocol of the virtual server (http, https, tcp, udp)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp", "udp"}, false),
+				Default:      "tcp",
+			},
+			"vip_hostname": {
+				Type:        schema.TypeString,
+				Description: "The hostname of the virtual server",
+				Optional:    true,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the load balancer pool traffic is sent to",
+				Optional:    true,
+			},
+			"profile_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the load balancer profiles applied to the virtual server",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Description:      "A JSON object of the type specific settings of the load balancer virtual server",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the load balancer virtual server",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithParentID("load_balancer_id"),
+		},
+	}
+}
+
+func resourceLoadBalancerVirtualServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	virtualServer, err := parseLoadBalancerVirtualServerPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/virtual-servers", d.Get("load_balancer_id").(int)),
+		Body: map[string]interface{}{
+			"virtualServer": virtualServer,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var virtualServerPayload LoadBalancerVirtualServerPayload
+	if err := json.Unmarshal(resp.Body, &virtualServerPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(virtualServerPayload.VirtualServer.ID))
+
+	resourceLoadBalancerVirtualServerRead(ctx, d, meta)
+	return diags
+}
+
+func resourceLoadBalancerVirtualServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/virtual-servers/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var virtualServerPayload LoadBalancerVirtualServerPayload
+	if err := json.Unmarshal(resp.Body, &virtualServerPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	virtualServer := virtualServerPayload.VirtualServer
+	d.SetId(int64ToString(virtualServer.ID))
+	d.Set("name", virtualServer.VipName)
+	d.Set("description", virtualServer.Description)
+	d.Set("vip_address", virtualServer.VipAddress)
+	d.Set("vip_port", apiInt64(virtualServer.VipPort))
+	d.Set("vip_protocol", virtualServer.VipProtocol)
+	d.Set("vip_hostname", virtualServer.VipHostname)
+	d.Set("pool_id", virtualServer.Pool.ID)
+	d.Set("profile_ids", apiIDs(virtualServer.Profiles))
+	d.Set("status", virtualServer.VipStatus)
+	return diags
+}
+
+func resourceLoadBalancerVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	virtualServer, err := parseLoadBalancerVirtualServerPayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/virtual-servers/%s", d.Get("load_balancer_id").(int), id),
+		Body: map[string]interface{}{
+			"virtualServer": virtualServer,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceLoadBalancerVirtualServerRead(ctx, d, meta)
+}
+
+func resourceLoadBalancerVirtualServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/load-balancers/%d/virtual-servers/%s", d.Get("load_balancer_id").(int), id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseLoadBalancerVirtualServerPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	config, err := expandLoadBalancerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]int, 0)
+	for _, profileID := range d.Get("profile_ids").(*schema.Set).List() {
+		profiles = append(profiles, profileID.(int))
+	}
+	virtualServer := map[string]interface{}{
+		"vipName":     d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"vipAddress":  d.Get("vip_address").(string),
+		"vipPort":     d.Get("vip_port").(int),
+		"vipProtocol": d.Get("vip_protocol").(string),
+		"vipHostname": d.Get("vip_hostname").(string),
+		"profiles":    profiles,
+		"config":      config,
+	}
+	// an empty id detaches the pool
+	virtualServer["pool"] = map[string]interface{}{"id": nil}
+	if poolID, ok := d.GetOk("pool_id"); ok {
+		virtualServer["pool"] = map[string]interface{}{"id": poolID.(int)}
+	}
+	return virtualServer, nil
+}
+
+type LoadBalancerVirtualServerPayload struct {
+	VirtualServer struct {
+		ID          int64       `json:"id"`
+		VipName     string      `json:"vipName"`
+		Description string      `json:"description"`
+		VipAddress  string      `json:"vipAddress"`
+		VipPort     interface{} `json:"vipPort"`
+		VipProtocol string      `json:"vipProtocol"`
+		VipHostname string      `json:"vipHostname"`
+		VipStatus   string      `json:"vipStatus"`
+		Pool        struct {
+			ID int64 `json:"id"`
+		} `json:"pool"`
+		Profiles []interface{} `json:"profiles"`
+	} `json:"virtualServer"`
+}
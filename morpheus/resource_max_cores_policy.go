@@ -102,7 +102,7 @@ func resourceMaxCoresPolicy() *schema.Resource {
 }
 
 func resourceMaxCoresPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -172,7 +172,7 @@ func resourceMaxCoresPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -244,7 +244,7 @@ func resourceMaxCoresPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -311,7 +311,7 @@ func resourceMaxCoresPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMaxCoresPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -102,7 +102,7 @@ func resourceMaxMemoryPolicy() *schema.Resource {
 }
 
 func resourceMaxMemoryPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -172,7 +172,7 @@ func resourceMaxMemoryPolicyCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceMaxMemoryPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -244,7 +244,7 @@ func resourceMaxMemoryPolicyRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMaxMemoryPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -311,7 +311,7 @@ func resourceMaxMemoryPolicyUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceMaxMemoryPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -84,7 +84,7 @@ func resourceMotdPolicy() *schema.Resource {
 }
 
 func resourceMotdPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -131,7 +131,7 @@ func resourceMotdPolicyCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMotdPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -191,7 +191,7 @@ func resourceMotdPolicyRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceMotdPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -235,7 +235,7 @@ func resourceMotdPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMotdPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -21,7 +21,7 @@ func resourceMVMInstance() *schema.Resource {
 		UpdateContext: resourceMVMInstanceUpdate,
 		DeleteContext: resourceMVMInstanceDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(3 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -302,6 +302,27 @@ func resourceMVMInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"power_state": powerStateSchema(),
+			"force_delete": {
+				Description: "Whether to force the deletion of the instance, even if the removal of its resources fails. Defaults to the force_delete argument of the provider",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Description: "Whether to preserve the volumes of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keep_backups": {
+				Description: "Whether to keep the backups of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"release_ips": {
+				Description: "Whether to release the elastic and floating IP addresses of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -310,7 +331,7 @@ func resourceMVMInstance() *schema.Resource {
 }
 
 func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -515,10 +536,10 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 			instanceStatus = instance.Status
 			return result, instance.Status, nil
 		},
-		Timeout:      3 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        2 * time.Minute,
-		PollInterval: 30 * time.Second,
+		Delay:        pollDelay(meta, 2*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -529,6 +550,15 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+
+	// Stop or suspend the instance once it is provisioned. The id is set
+	// first so the instance is kept in state if this fails
+	if powerState, ok := d.GetOk("power_state"); ok && instanceStatus != "failed" {
+		if err := setInstancePowerState(ctx, meta, instance.ID, instanceStatus, powerState.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error setting power state of instance: %s", err)
+		}
+	}
+
 	resourceMVMInstanceRead(ctx, d, meta)
 
 	// Fail the instance deployment if the
@@ -540,7 +570,7 @@ func resourceMVMInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -588,6 +618,7 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("plan_id", instance.Plan.ID)
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	setPowerStateFromStatus(d, instance.Status)
 
 	var evars []map[string]interface{}
 	for i := 0; i < len(instance.EnvironmentVariables); i++ {
@@ -663,7 +694,7 @@ func resourceMVMInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	instanceGetResp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
@@ -780,7 +811,7 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"resizing", "pending"},
-		Target:  []string{"running"},
+		Target:  []string{"running", "stopped", "suspended"},
 		Refresh: func() (interface{}, string, error) {
 			instanceDetails, err := client.GetInstance(toInt64(id), &morpheus.Request{})
 			if err != nil {
@@ -790,10 +821,10 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 			instance := result.Instance
 			return result, instance.Status, nil
 		},
-		Timeout:      30 * time.Minute,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 30 * time.Second,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -802,13 +833,19 @@ func resourceMVMInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error updating instance: %s", err)
 	}
 
+	if d.HasChange("power_state") {
+		if err := setInstancePowerState(ctx, meta, toInt64(id), "", d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating power state of instance: %s", err)
+		}
+	}
+
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
 	return resourceMVMInstanceRead(ctx, d, meta)
 }
 
 func resourceMVMInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -817,9 +854,7 @@ func resourceMVMInstanceDelete(ctx context.Context, d *schema.ResourceData, meta
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
-	if USE_FORCE {
-		req.QueryParams["force"] = "true"
-	}
+	deleteQueryParams(d, meta, req.QueryParams)
 	resp, err := client.DeleteInstance(toInt64(id), req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -847,10 +882,10 @@ func resourceMVMInstanceDelete(ctx context.Context, d *schema.ResourceData, meta
 			instance := result.Instance
 			return result, instance.Status, nil
 		},
-		Timeout:      30 * time.Minute,
+		Timeout:      d.Timeout(schema.TimeoutDelete),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 30 * time.Second,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -0,0 +1,455 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network resource",
+		CreateContext: resourceNetworkCreate,
+		ReadContext:   resourceNetworkRead,
+		UpdateContext: resourceNetworkUpdate,
+		DeleteContext: resourceNetworkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network",
+				Required:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The user friendly name of the network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network",
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the network (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud to create the network in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network type, the available types depend on the type of the cloud",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"resource_pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the resource pool (VPC, resource group or cluster) of the cloud to create the network in",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Description:  "The CIDR of the network (e.g. 10.100.0.0/24)",
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Description:  "The gateway of the network",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_primary": {
+				Type:         schema.TypeString,
+				Description:  "The primary DNS server of the network",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_secondary": {
+				Type:         schema.TypeString,
+				Description:  "The secondary DNS server of the network",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"search_domains": {
+				Type:        schema.TypeString,
+				Description: "The comma separated list of DNS search domains of the network",
+				Optional:    true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Description:  "The VLAN ID of the network",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			"dhcp_server": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network has a DHCP server",
+				Optional:    true,
+				Default:     false,
+			},
+			"allow_static_override": {
+				Type:        schema.TypeBool,
+				Description: "Whether IP addresses can be set manually when provisioning to the network",
+				Optional:    true,
+				Default:     false,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the IP pool IP addresses are assigned from",
+				Optional:    true,
+			},
+			"network_domain_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network domain of the network",
+				Optional:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the network is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"all_group_access": {
+				Type:        schema.TypeBool,
+				Description: "Whether all groups will be granted access to the network",
+				Optional:    true,
+			},
+			"group_access": {
+				Type:        schema.TypeList,
+				Description: "A list of Morpheus group configuration to enable group access to the network",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Morpheus group to grant access to the network",
+							Required:    true,
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Description: "Whether the network will be a default for the associated group",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids granted access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	network := parseNetworkPayload(d)
+	network["zone"] = map[string]interface{}{
+		"id": d.Get("cloud_id").(int),
+	}
+	network["type"] = map[string]interface{}{
+		"id": d.Get("type_id").(int),
+	}
+	if resourcePoolID, ok := d.GetOk("resource_pool_id"); ok {
+		network["zonePool"] = map[string]interface{}{
+			"id": resourcePoolID.(int),
+		}
+	}
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"network":             network,
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	}
+	resp, err := client.CreateNetwork(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateNetworkResult)
+	networkResult := result.Network
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkResult.ID))
+
+	resourceNetworkRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindNetworkByName(name)
+	} else if id != "" {
+		resp, err = client.GetNetwork(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Network cannot be read without name or id")
+	}
+
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var networkPayload NetworkPayload
+	if err := json.Unmarshal(resp.Body, &networkPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	network := networkPayload.Network
+	d.SetId(int64ToString(network.ID))
+	d.Set("name", network.Name)
+	d.Set("display_name", network.DisplayName)
+	d.Set("description", network.Description)
+	d.Set("labels", network.Labels)
+	d.Set("cloud_id", network.Zone.ID)
+	d.Set("type_id", network.Type.ID)
+	d.Set("resource_pool_id", network.ZonePool.ID)
+	d.Set("cidr", network.Cidr)
+	d.Set("gateway", network.Gateway)
+	d.Set("dns_primary", network.DnsPrimary)
+	d.Set("dns_secondary", network.DnsSecondary)
+	d.Set("search_domains", network.SearchDomains)
+	d.Set("vlan_id", network.VlanId)
+	d.Set("dhcp_server", network.DhcpServer)
+	d.Set("allow_static_override", network.AllowStaticOverride)
+	d.Set("pool_id", network.Pool.ID)
+	d.Set("network_domain_id", network.NetworkDomain.ID)
+	d.Set("active", network.Active)
+	d.Set("visibility", network.Visibility)
+	setNetworkPermissions(d, network.ResourcePermission, network.Tenants)
+	return diags
+}
+
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"network":             parseNetworkPayload(d),
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	}
+	resp, err := client.UpdateNetwork(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateNetworkResult)
+	network := result.Network
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(network.ID))
+	return resourceNetworkRead(ctx, d, meta)
+}
+
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteNetwork(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// parseNetworkPayload returns the attributes of a network that can be set
+// both when it is created and updated.
+func parseNetworkPayload(d *schema.ResourceData) map[string]interface{} {
+	network := map[string]interface{}{
+		"name":                d.Get("name").(string),
+		"description":         d.Get("description").(string),
+		"labels":              d.Get("labels").(*schema.Set).List(),
+		"cidr":                d.Get("cidr").(string),
+		"gateway":             d.Get("gateway").(string),
+		"dnsPrimary":          d.Get("dns_primary").(string),
+		"dnsSecondary":        d.Get("dns_secondary").(string),
+		"searchDomains":       d.Get("search_domains").(string),
+		"vlanId":              d.Get("vlan_id").(int),
+		"dhcpServer":          d.Get("dhcp_server").(bool),
+		"allowStaticOverride": d.Get("allow_static_override").(bool),
+		"active":              d.Get("active").(bool),
+		"visibility":          d.Get("visibility").(string),
+	}
+	if displayName, ok := d.GetOk("display_name"); ok {
+		network["displayName"] = displayName.(string)
+	}
+	// an empty id unassigns the pool or domain
+	network["pool"] = map[string]interface{}{"id": nil}
+	if poolID, ok := d.GetOk("pool_id"); ok {
+		network["pool"] = map[string]interface{}{"id": poolID.(int)}
+	}
+	network["networkDomain"] = map[string]interface{}{"id": nil}
+	if domainID, ok := d.GetOk("network_domain_id"); ok {
+		network["networkDomain"] = map[string]interface{}{"id": domainID.(int)}
+	}
+	return network
+}
+
+// parseNetworkPermissions returns the group and tenant access payloads of
+// the network, subnet and network group resources.
+func parseNetworkPermissions(d *schema.ResourceData) (map[string]interface{}, map[string]interface{}) {
+	tenantsPayload := make([]int, 0)
+	if attr, ok := d.GetOk("tenant_ids"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			tenantsPayload = append(tenantsPayload, s.(int))
+		}
+	}
+	tenantPermissions := map[string]interface{}{
+		"accounts": tenantsPayload,
+	}
+
+	resourcePermissions := map[string]interface{}{
+		"all": d.Get("all_group_access").(bool),
+	}
+	// Group Access
+	if groupAccess := parseGroupAccess(d.Get("group_access").([]interface{})); groupAccess != nil {
+		resourcePermissions["sites"] = groupAccess
+	}
+	return resourcePermissions, tenantPermissions
+}
+
+// setNetworkPermissions stores the group and tenant access of a network,
+// subnet or network group.
+func setNetworkPermissions(d *schema.ResourceData, permission NetworkResourcePermission, tenants []NetworkTenant) {
+	d.Set("all_group_access", permission.All)
+	var groupAccess []map[string]interface{}
+	for _, group := range permission.Sites {
+		groupAccess = append(groupAccess, map[string]interface{}{
+			"group_id": group.ID,
+			"default":  group.Default,
+		})
+	}
+	d.Set("group_access", groupAccess)
+	var tenantIds []int64
+	for _, tenant := range tenants {
+		tenantIds = append(tenantIds, tenant.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+}
+
+type NetworkResourcePermission struct {
+	All   bool `json:"all"`
+	Sites []struct {
+		ID      int64 `json:"id"`
+		Default bool  `json:"default"`
+	} `json:"sites"`
+}
+
+type NetworkTenant struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type NetworkPayload struct {
+	Network struct {
+		ID          int64    `json:"id"`
+		Name        string   `json:"name"`
+		DisplayName string   `json:"displayName"`
+		Description string   `json:"description"`
+		Labels      []string `json:"labels"`
+		Zone        struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Type struct {
+			ID int64 `json:"id"`
+		} `json:"type"`
+		ZonePool struct {
+			ID int64 `json:"id"`
+		} `json:"zonePool"`
+		Cidr                string `json:"cidr"`
+		Gateway             string `json:"gateway"`
+		DnsPrimary          string `json:"dnsPrimary"`
+		DnsSecondary        string `json:"dnsSecondary"`
+		SearchDomains       string `json:"searchDomains"`
+		VlanId              int64  `json:"vlanId"`
+		DhcpServer          bool   `json:"dhcpServer"`
+		AllowStaticOverride bool   `json:"allowStaticOverride"`
+		Pool                struct {
+			ID int64 `json:"id"`
+		} `json:"pool"`
+		NetworkDomain struct {
+			ID int64 `json:"id"`
+		} `json:"networkDomain"`
+		Active             bool                      `json:"active"`
+		Visibility         string                    `json:"visibility"`
+		ResourcePermission NetworkResourcePermission `json:"resourcePermission"`
+		Tenants            []NetworkTenant           `json:"tenants"`
+	} `json:"network"`
+}
@@ -89,7 +89,7 @@ func resourceNetworkDomain() *schema.Resource {
 }
 
 func resourceNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -126,7 +126,7 @@ func resourceNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -178,7 +178,7 @@ func resourceNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceNetworkDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -212,7 +212,7 @@ func resourceNetworkDomainUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceNetworkDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,261 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network group resource",
+		CreateContext: resourceNetworkGroupCreate,
+		ReadContext:   resourceNetworkGroupRead,
+		UpdateContext: resourceNetworkGroupUpdate,
+		DeleteContext: resourceNetworkGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network group",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network group",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network group",
+				Optional:    true,
+			},
+			"network_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of network ids associated with the network group",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"subnet_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of network subnet ids associated with the network group",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network group is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the network group is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"all_group_access": {
+				Type:        schema.TypeBool,
+				Description: "Whether all groups will be granted access to the network group",
+				Optional:    true,
+			},
+			"group_access": {
+				Type:        schema.TypeList,
+				Description: "A list of Morpheus group configuration to enable group access to the network group",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Morpheus group to grant access to the network group",
+							Required:    true,
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Description: "Whether the network group will be a default for the associated group",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids granted access to the network group",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkGroup":        parseNetworkGroupPayload(d),
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	}
+	resp, err := client.CreateNetworkGroup(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateNetworkGroupResult)
+	networkGroup := result.NetworkGroup
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkGroup.ID))
+
+	resourceNetworkGroupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindNetworkGroupByName(name)
+	} else if id != "" {
+		resp, err = client.GetNetworkGroup(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Network group cannot be read without name or id")
+	}
+
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var networkGroupPayload NetworkGroupPayload
+	if err := json.Unmarshal(resp.Body, &networkGroupPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	networkGroup := networkGroupPayload.NetworkGroup
+	d.SetId(int64ToString(networkGroup.ID))
+	d.Set("name", networkGroup.Name)
+	d.Set("description", networkGroup.Description)
+	d.Set("network_ids", apiIDs(networkGroup.Networks))
+	d.Set("subnet_ids", apiIDs(networkGroup.Subnets))
+	d.Set("active", networkGroup.Active)
+	d.Set("visibility", networkGroup.Visibility)
+	setNetworkPermissions(d, networkGroup.ResourcePermission, networkGroup.Tenants)
+	return diags
+}
+
+func resourceNetworkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkGroup":        parseNetworkGroupPayload(d),
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	}
+	resp, err := client.UpdateNetworkGroup(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateNetworkGroupResult)
+	networkGroup := result.NetworkGroup
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(networkGroup.ID))
+	return resourceNetworkGroupRead(ctx, d, meta)
+}
+
+func resourceNetworkGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteNetworkGroup(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseNetworkGroupPayload(d *schema.ResourceData) map[string]interface{} {
+	networksPayload := make([]int, 0)
+	for _, networkID := range d.Get("network_ids").(*schema.Set).List() {
+		networksPayload = append(networksPayload, networkID.(int))
+	}
+	subnetsPayload := make([]int, 0)
+	for _, subnetID := range d.Get("subnet_ids").(*schema.Set).List() {
+		subnetsPayload = append(subnetsPayload, subnetID.(int))
+	}
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"networks":    networksPayload,
+		"subnets":     subnetsPayload,
+		"active":      d.Get("active").(bool),
+		"visibility":  d.Get("visibility").(string),
+	}
+}
+
+type NetworkGroupPayload struct {
+	NetworkGroup struct {
+		ID                 int64                     `json:"id"`
+		Name               string                    `json:"name"`
+		Description        string                    `json:"description"`
+		Networks           []interface{}             `json:"networks"`
+		Subnets            []interface{}             `json:"subnets"`
+		Active             bool                      `json:"active"`
+		Visibility         string                    `json:"visibility"`
+		ResourcePermission NetworkResourcePermission `json:"resourcePermission"`
+		Tenants            []NetworkTenant           `json:"tenants"`
+	} `json:"networkGroup"`
+}
@@ -102,7 +102,7 @@ func resourceNetworkQuotaPolicy() *schema.Resource {
 }
 
 func resourceNetworkQuotaPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -172,7 +172,7 @@ func resourceNetworkQuotaPolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNetworkQuotaPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -244,7 +244,7 @@ func resourceNetworkQuotaPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNetworkQuotaPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -311,7 +311,7 @@ func resourceNetworkQuotaPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceNetworkQuotaPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
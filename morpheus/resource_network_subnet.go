@@ -0,0 +1,316 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkSubnet() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network subnet resource",
+		CreateContext: resourceNetworkSubnetCreate,
+		ReadContext:   resourceNetworkSubnetRead,
+		UpdateContext: resourceNetworkSubnetUpdate,
+		DeleteContext: resourceNetworkSubnetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network subnet",
+				Computed:    true,
+			},
+			"network_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network to create the subnet in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network subnet",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network subnet",
+				Optional:    true,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Description:  "The CIDR of the network subnet (e.g. 10.100.1.0/24)",
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Description:  "The gateway of the network subnet",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_primary": {
+				Type:         schema.TypeString,
+				Description:  "The primary DNS server of the network subnet",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_secondary": {
+				Type:         schema.TypeString,
+				Description:  "The secondary DNS server of the network subnet",
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"search_domains": {
+				Type:        schema.TypeString,
+				Description: "The comma separated list of DNS search domains of the network subnet",
+				Optional:    true,
+			},
+			"dhcp_server": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network subnet has a DHCP server",
+				Optional:    true,
+				Default:     false,
+			},
+			"allow_static_override": {
+				Type:        schema.TypeBool,
+				Description: "Whether IP addresses can be set manually when provisioning to the network subnet",
+				Optional:    true,
+				Default:     false,
+			},
+			"pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the IP pool IP addresses are assigned from",
+				Optional:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network subnet is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the network subnet is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"all_group_access": {
+				Type:        schema.TypeBool,
+				Description: "Whether all groups will be granted access to the network subnet",
+				Optional:    true,
+			},
+			"group_access": {
+				Type:        schema.TypeList,
+				Description: "A list of Morpheus group configuration to enable group access to the network subnet",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Morpheus group to grant access to the network subnet",
+							Required:    true,
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Description: "Whether the network subnet will be a default for the associated group",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids granted access to the network subnet",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/networks/%d/subnets", d.Get("network_id").(int)),
+		Body: map[string]interface{}{
+			"subnet":              parseNetworkSubnetPayload(d),
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var subnetPayload NetworkSubnetPayload
+	if err := json.Unmarshal(resp.Body, &subnetPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(subnetPayload.Subnet.ID))
+
+	resourceNetworkSubnetRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetNetworkSubnet(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var subnetPayload NetworkSubnetPayload
+	if err := json.Unmarshal(resp.Body, &subnetPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	subnet := subnetPayload.Subnet
+	d.SetId(int64ToString(subnet.ID))
+	if subnet.Network.ID != 0 {
+		d.Set("network_id", subnet.Network.ID)
+	}
+	d.Set("name", subnet.Name)
+	d.Set("description", subnet.Description)
+	d.Set("cidr", subnet.Cidr)
+	d.Set("gateway", subnet.Gateway)
+	d.Set("dns_primary", subnet.DnsPrimary)
+	d.Set("dns_secondary", subnet.DnsSecondary)
+	d.Set("search_domains", subnet.SearchDomains)
+	d.Set("dhcp_server", subnet.DhcpServer)
+	d.Set("allow_static_override", subnet.AllowStaticOverride)
+	d.Set("pool_id", subnet.Pool.ID)
+	d.Set("active", subnet.Active)
+	d.Set("visibility", subnet.Visibility)
+	setNetworkPermissions(d, subnet.ResourcePermission, subnet.Tenants)
+	return diags
+}
+
+func resourceNetworkSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resourcePermissions, tenantPermissions := parseNetworkPermissions(d)
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/subnets/%s", id),
+		Body: map[string]interface{}{
+			"subnet":              parseNetworkSubnetPayload(d),
+			"resourcePermissions": resourcePermissions,
+			"tenantPermissions":   tenantPermissions,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceNetworkSubnetRead(ctx, d, meta)
+}
+
+func resourceNetworkSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/subnets/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// parseNetworkSubnetPayload returns the attributes of a subnet that can be
+// set both when it is created and updated.
+func parseNetworkSubnetPayload(d *schema.ResourceData) map[string]interface{} {
+	subnet := map[string]interface{}{
+		"name":                d.Get("name").(string),
+		"description":         d.Get("description").(string),
+		"cidr":                d.Get("cidr").(string),
+		"gateway":             d.Get("gateway").(string),
+		"dnsPrimary":          d.Get("dns_primary").(string),
+		"dnsSecondary":        d.Get("dns_secondary").(string),
+		"searchDomains":       d.Get("search_domains").(string),
+		"dhcpServer":          d.Get("dhcp_server").(bool),
+		"allowStaticOverride": d.Get("allow_static_override").(bool),
+		"active":              d.Get("active").(bool),
+		"visibility":          d.Get("visibility").(string),
+	}
+	// an empty id unassigns the pool
+	subnet["pool"] = map[string]interface{}{"id": nil}
+	if poolID, ok := d.GetOk("pool_id"); ok {
+		subnet["pool"] = map[string]interface{}{"id": poolID.(int)}
+	}
+	return subnet
+}
+
+type NetworkSubnetPayload struct {
+	Subnet struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Network     struct {
+			ID int64 `json:"id"`
+		} `json:"network"`
+		Cidr                string `json:"cidr"`
+		Gateway             string `json:"gateway"`
+		DnsPrimary          string `json:"dnsPrimary"`
+		DnsSecondary        string `json:"dnsSecondary"`
+		SearchDomains       string `json:"searchDomains"`
+		DhcpServer          bool   `json:"dhcpServer"`
+		AllowStaticOverride bool   `json:"allowStaticOverride"`
+		Pool                struct {
+			ID int64 `json:"id"`
+		} `json:"pool"`
+		Active             bool                      `json:"active"`
+		Visibility         string                    `json:"visibility"`
+		ResourcePermission NetworkResourcePermission `json:"resourcePermission"`
+		Tenants            []NetworkTenant           `json:"tenants"`
+	} `json:"subnet"`
+}
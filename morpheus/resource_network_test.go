@@ -0,0 +1,99 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusNetwork_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_network.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_network"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusNetworkConfig("10.100.0.1", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "type_id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.100.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "10.100.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "vlan_id", "100"),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "private"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusNetworkConfig("10.100.0.254", 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gateway", "10.100.0.254"),
+					resource.TestCheckResourceAttr(resourceName, "vlan_id", "200"),
+					resource.TestCheckResourceAttr(resourceName, "cloud_id", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMorpheusNetworkGroup_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_network_group.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_network_group"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusNetworkGroupConfig("[10, 11]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "network_ids.*", "11"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusNetworkGroupConfig("[10]"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "network_ids.#", "1"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusNetworkConfig(gateway string, vlanID int) string {
+	return fmt.Sprintf(`
+resource "morpheus_network" "tfacc" {
+  name     = "tfacc"
+  cloud_id = 1
+  type_id  = 2
+  cidr     = "10.100.0.0/24"
+  gateway  = %q
+  vlan_id  = %d
+}
+`, gateway, vlanID)
+}
+
+func testAccMorpheusNetworkGroupConfig(networkIDs string) string {
+	return fmt.Sprintf(`
+resource "morpheus_network_group" "tfacc" {
+  name        = "tfacc"
+  description = "tfacc"
+  network_ids = %s
+}
+`, networkIDs)
+}
@@ -128,7 +128,7 @@ func resourceNumberOptionType() *schema.Resource {
 }
 
 func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -181,7 +181,7 @@ func resourceNumberOptionTypeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -242,7 +242,7 @@ func resourceNumberOptionTypeRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -290,7 +290,7 @@ func resourceNumberOptionTypeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNumberOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
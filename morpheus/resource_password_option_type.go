@@ -134,7 +134,7 @@ func resourcePasswordOptionType() *schema.Resource {
 }
 
 func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -188,7 +188,7 @@ func resourcePasswordOptionTypeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -250,7 +250,7 @@ func resourcePasswordOptionTypeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -298,7 +298,7 @@ func resourcePasswordOptionTypeUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePasswordOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,530 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Morpheus policy resource for any policy type. The config is validated against the option types of the policy type",
+		CreateContext: resourcePolicyCreate,
+		ReadContext:   resourcePolicyRead,
+		UpdateContext: resourcePolicyUpdate,
+		DeleteContext: resourcePolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the policy",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the policy",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the policy",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the policy is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"policy_type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the policy type, such as maxCores, naming or approveReconfigure",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config": {
+				Type:             schema.TypeMap,
+				Description:      "The settings of the policy keyed by the field name of the policy type's option types. Numbers and booleans are given as strings, e.g. \"4\" or \"true\"",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressEquivalentPolicyConfigValues,
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Description:  "The filter or scope that the policy is applied to (global, group, cloud, user, role)",
+				ValidateFunc: validation.StringInSlice([]string{"global", "group", "cloud", "user", "role"}, false),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"group_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the group associated with the group scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "role_id"},
+			},
+			"cloud_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the cloud associated with the cloud scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_id", "user_id", "role_id"},
+			},
+			"user_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the user associated with the user scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "group_id", "role_id"},
+			},
+			"role_id": {
+				Type:          schema.TypeInt,
+				Description:   "The id of the role associated with the role scoped filter",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+			},
+			"apply_to_each_user": {
+				Type:          schema.TypeBool,
+				Description:   "Whether to assign the policy at the individual user level to all users assigned the associated role",
+				Optional:      true,
+				ConflictsWith: []string{"cloud_id", "user_id", "group_id"},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeList,
+				Description: "A list of tenant IDs to assign the policy to",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		CustomizeDiff: policyConfigCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	policy, err := parsePolicyPayload(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": policy,
+		},
+	}
+	resp, err := client.CreatePolicy(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var policyPayload PolicyResourcePayload
+	if err := json.Unmarshal(resp.Body, &policyPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(policyPayload.Policy.ID))
+
+	resourcePolicyRead(ctx, d, meta)
+	return diags
+}
+
+func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetPolicy(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var policyPayload PolicyResourcePayload
+	if err := json.Unmarshal(resp.Body, &policyPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	policy := policyPayload.Policy
+	d.SetId(int64ToString(policy.ID))
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.Enabled)
+	d.Set("policy_type_code", policy.PolicyType.Code)
+	d.Set("config", flattenPolicyConfig(policy.Config, d.Get("config").(map[string]interface{})))
+	setPolicyScope(d, policy.RefType, policy.Site.ID, policy.Zone.ID, policy.User.ID, policy.Role.ID, policy.EachUser)
+	d.Set("tenant_ids", apiIDs(policy.Accounts))
+	return diags
+}
+
+func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	policy, err := parsePolicyPayload(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"policy": policy,
+		},
+	}
+	resp, err := client.UpdatePolicy(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourcePolicyRead(ctx, d, meta)
+}
+
+func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeletePolicy(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parsePolicyPayload(client *morpheus.Client, d *schema.ResourceData) (map[string]interface{}, error) {
+	policyTypeCode := d.Get("policy_type_code").(string)
+	optionTypes, err := getPolicyTypeOptionTypes(client, policyTypeCode)
+	if err != nil {
+		return nil, err
+	}
+	config, err := expandPolicyConfig(policyTypeCode, d.Get("config").(map[string]interface{}), optionTypes)
+	if err != nil {
+		return nil, err
+	}
+	policy := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"enabled":     d.Get("enabled").(bool),
+		"config":      config,
+		"policyType": map[string]interface{}{
+			"code": policyTypeCode,
+		},
+		"accounts": d.Get("tenant_ids"),
+	}
+	parsePolicyScope(d, policy)
+	return policy, nil
+}
+
+// parsePolicyScope adds the reference of the group, cloud, user or role the
+// policy is scoped to. Global policies have no reference.
+func parsePolicyScope(d *schema.ResourceData, policy map[string]interface{}) {
+	switch d.Get("scope") {
+	case "group":
+		policy["refId"] = d.Get("group_id").(int)
+		policy["refType"] = "ComputeSite"
+		policy["site"] = map[string]interface{}{
+			"id": d.Get("group_id").(int),
+		}
+	case "cloud":
+		policy["refId"] = d.Get("cloud_id").(int)
+		policy["refType"] = "ComputeZone"
+		policy["zone"] = map[string]interface{}{
+			"id": d.Get("cloud_id").(int),
+		}
+	case "user":
+		policy["refId"] = d.Get("user_id").(int)
+		policy["refType"] = "User"
+		policy["user"] = map[string]interface{}{
+			"id": d.Get("user_id").(int),
+		}
+	case "role":
+		policy["refId"] = d.Get("role_id").(int)
+		policy["refType"] = "Role"
+		policy["eachUser"] = d.Get("apply_to_each_user").(bool)
+		policy["role"] = map[string]interface{}{
+			"id": d.Get("role_id").(int),
+		}
+	}
+}
+
+// setPolicyScope stores the scope of a policy from the type of the object
+// it references.
+func setPolicyScope(d *schema.ResourceData, refType string, groupID, cloudID, userID, roleID int64, eachUser bool) {
+	switch refType {
+	case "ComputeSite":
+		d.Set("scope", "group")
+		d.Set("group_id", groupID)
+	case "ComputeZone":
+		d.Set("scope", "cloud")
+		d.Set("cloud_id", cloudID)
+	case "User":
+		d.Set("scope", "user")
+		d.Set("user_id", userID)
+	case "Role":
+		d.Set("scope", "role")
+		d.Set("role_id", roleID)
+		d.Set("apply_to_each_user", eachUser)
+	default:
+		d.Set("scope", "global")
+	}
+}
+
+// policyConfigCustomizeDiff validates the config against the option types of
+// the policy type at plan time, when the config is known.
+func policyConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("config") || !d.NewValueKnown("policy_type_code") {
+		return nil
+	}
+	if !d.HasChange("config") && !d.HasChange("policy_type_code") {
+		return nil
+	}
+	client := meta.(*providerMeta).client
+	policyTypeCode := d.Get("policy_type_code").(string)
+	optionTypes, err := getPolicyTypeOptionTypes(client, policyTypeCode)
+	if err != nil {
+		return err
+	}
+	_, err = expandPolicyConfig(policyTypeCode, d.Get("config").(map[string]interface{}), optionTypes)
+	return err
+}
+
+// getPolicyTypeOptionTypes looks up a policy type by code and returns its
+// option types.
+func getPolicyTypeOptionTypes(client *morpheus.Client, code string) ([]PolicyOptionType, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   "/api/policy-types",
+		QueryParams: map[string]string{
+			"code": code,
+			"max":  "-1",
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var policyTypesPayload PolicyTypesPayload
+	if err := json.Unmarshal(resp.Body, &policyTypesPayload); err != nil {
+		return nil, err
+	}
+	var policyTypeID int64
+	for _, policyType := range policyTypesPayload.PolicyTypes {
+		if policyType.Code == code {
+			policyTypeID = policyType.ID
+		}
+	}
+	if policyTypeID == 0 {
+		return nil, fmt.Errorf("policy type %q not found", code)
+	}
+
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/policy-types/%d", policyTypeID),
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var policyTypePayload PolicyTypePayload
+	if err := json.Unmarshal(resp.Body, &policyTypePayload); err != nil {
+		return nil, err
+	}
+	return policyTypePayload.PolicyType.OptionTypes, nil
+}
+
+// expandPolicyConfig validates the config against the option types of the
+// policy type and converts each value to the type of its option type.
+func expandPolicyConfig(policyTypeCode string, config map[string]interface{}, optionTypes []PolicyOptionType) (map[string]interface{}, error) {
+	fields := make(map[string]PolicyOptionType)
+	var fieldNames []string
+	for _, optionType := range optionTypes {
+		fieldName := optionType.configKey()
+		fields[fieldName] = optionType
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	payload := make(map[string]interface{})
+	for key, v := range config {
+		optionType, ok := fields[key]
+		if !ok {
+			return nil, fmt.Errorf("config key %q is not an option of policy type %s, expected one of: %s", key, policyTypeCode, strings.Join(fieldNames, ", "))
+		}
+		value := v.(string)
+		switch optionType.Type {
+		case "number":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("config key %q of policy type %s must be a number, got %q", key, policyTypeCode, value)
+			}
+			if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+				payload[key] = i
+			} else {
+				payload[key], _ = strconv.ParseFloat(value, 64)
+			}
+		case "checkbox":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("config key %q of policy type %s must be true or false, got %q", key, policyTypeCode, value)
+			}
+			payload[key] = evaluateStringBoolean(b)
+		default:
+			payload[key] = value
+		}
+	}
+	for _, fieldName := range fieldNames {
+		optionType := fields[fieldName]
+		if _, ok := config[fieldName]; !ok && optionType.Required && optionType.DefaultValue == nil {
+			return nil, fmt.Errorf("config key %q is required by policy type %s", fieldName, policyTypeCode)
+		}
+	}
+	return payload, nil
+}
+
+// flattenPolicyConfig converts the config returned by the API to strings.
+// Only the keys already in the state are kept once it has been set, so that
+// defaults added by the API do not show up as changes.
+func flattenPolicyConfig(config map[string]interface{}, current map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, v := range config {
+		if _, ok := current[key]; len(current) > 0 && !ok {
+			continue
+		}
+		var value string
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			value = v
+		case bool:
+			value = strconv.FormatBool(v)
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			continue
+		}
+		if value == "" && len(current) == 0 {
+			continue
+		}
+		values[key] = value
+	}
+	return values
+}
+
+// suppressEquivalentPolicyConfigValues ignores the differences between the
+// spellings of a checkbox value, "on" and "true" or "" and "false".
+func suppressEquivalentPolicyConfigValues(k, old, new string, d *schema.ResourceData) bool {
+	return policyConfigBoolean(old) != "" && policyConfigBoolean(old) == policyConfigBoolean(new)
+}
+
+func policyConfigBoolean(value string) string {
+	switch value {
+	case "on", "true":
+		return "true"
+	case "", "off", "false":
+		return "false"
+	}
+	return ""
+}
+
+type PolicyOptionType struct {
+	Name         string      `json:"name"`
+	FieldName    string      `json:"fieldName"`
+	FieldContext string      `json:"fieldContext"`
+	Type         string      `json:"type"`
+	Required     bool        `json:"required"`
+	DefaultValue interface{} `json:"defaultValue"`
+}
+
+// configKey returns the key of the option type in the policy config.
+func (o PolicyOptionType) configKey() string {
+	return strings.TrimPrefix(o.FieldName, "config.")
+}
+
+type PolicyTypesPayload struct {
+	PolicyTypes []struct {
+		ID   int64  `json:"id"`
+		Code string `json:"code"`
+		Name string `json:"name"`
+	} `json:"policyTypes"`
+}
+
+type PolicyTypePayload struct {
+	PolicyType struct {
+		ID          int64              `json:"id"`
+		Code        string             `json:"code"`
+		Name        string             `json:"name"`
+		OptionTypes []PolicyOptionType `json:"optionTypes"`
+	} `json:"policyType"`
+}
+
+type PolicyResourcePayload struct {
+	Policy struct {
+		ID          int64                  `json:"id"`
+		Name        string                 `json:"name"`
+		Description string                 `json:"description"`
+		Enabled     bool                   `json:"enabled"`
+		Config      map[string]interface{} `json:"config"`
+		PolicyType  struct {
+			Code string `json:"code"`
+		} `json:"policyType"`
+		RefType  string `json:"refType"`
+		EachUser bool   `json:"eachUser"`
+		Site     struct {
+			ID int64 `json:"id"`
+		} `json:"site"`
+		Zone struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+		Role struct {
+			ID int64 `json:"id"`
+		} `json:"role"`
+		Accounts []interface{} `json:"accounts"`
+	} `json:"policy"`
+}
@@ -0,0 +1,132 @@
+package morpheus
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusPolicy_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	srv.stub("GET", "/api/policy-types", http.StatusOK, map[string]interface{}{
+		"policyTypes": []interface{}{
+			map[string]interface{}{"id": 40, "code": "maxPoolMembers", "name": "Max Pool Members"},
+		},
+	})
+	srv.stub("GET", "/api/policy-types/40", http.StatusOK, map[string]interface{}{
+		"policyType": map[string]interface{}{
+			"id":   40,
+			"code": "maxPoolMembers",
+			"optionTypes": []interface{}{
+				map[string]interface{}{"fieldName": "maxPoolMembers", "type": "number", "required": true},
+				map[string]interface{}{"fieldName": "strict", "type": "checkbox"},
+			},
+		},
+	})
+	resourceName := "morpheus_policy.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_policy"),
+		Steps: []resource.TestStep{
+			{
+				Config:      srv.providerConfig() + testAccMorpheusPolicyConfig(`maxMembers = "10"`),
+				ExpectError: regexp.MustCompile(`config key "maxMembers" is not an option of policy type maxPoolMembers`),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusPolicyConfig(`maxPoolMembers = "10"
+    strict         = "true"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type_code", "maxPoolMembers"),
+					resource.TestCheckResourceAttr(resourceName, "config.maxPoolMembers", "10"),
+					resource.TestCheckResourceAttr(resourceName, "config.strict", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "group"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", "2"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusPolicyConfig(`maxPoolMembers = "20"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.maxPoolMembers", "20"),
+					resource.TestCheckNoResourceAttr(resourceName, "config.strict"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandPolicyConfig(t *testing.T) {
+	optionTypes := []PolicyOptionType{
+		{FieldName: "config.maxCores", Type: "number", Required: true},
+		{FieldName: "strict", Type: "checkbox"},
+		{FieldName: "pattern", Type: "text", Required: true, DefaultValue: "${userInitials}"},
+	}
+	for _, tc := range []struct {
+		name   string
+		config map[string]interface{}
+		want   map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "typed values",
+			config: map[string]interface{}{"maxCores": "4", "strict": "true", "pattern": "vm-${sequence}"},
+			want:   map[string]interface{}{"maxCores": int64(4), "strict": "on", "pattern": "vm-${sequence}"},
+		},
+		{
+			name:   "unknown key",
+			config: map[string]interface{}{"maxCores": "4", "maxCpus": "4"},
+			err:    `config key "maxCpus" is not an option of policy type maxCores, expected one of: maxCores, pattern, strict`,
+		},
+		{
+			name:   "missing required key",
+			config: map[string]interface{}{"strict": "false"},
+			err:    `config key "maxCores" is required by policy type maxCores`,
+		},
+		{
+			name:   "not a number",
+			config: map[string]interface{}{"maxCores": "four"},
+			err:    `must be a number`,
+		},
+		{
+			name:   "not a boolean",
+			config: map[string]interface{}{"maxCores": "4", "strict": "yes"},
+			err:    `must be true or false`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := expandPolicyConfig("maxCores", tc.config, optionTypes)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func testAccMorpheusPolicyConfig(config string) string {
+	return fmt.Sprintf(`
+resource "morpheus_policy" "tfacc" {
+  name             = "tfacc"
+  policy_type_code = "maxPoolMembers"
+  scope            = "group"
+  group_id         = 2
+  config = {
+    %s
+  }
+}
+`, config)
+}
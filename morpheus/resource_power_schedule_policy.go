@@ -115,7 +115,7 @@ func resourcePowerSchedulePolicy() *schema.Resource {
 }
 
 func resourcePowerSchedulePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -187,7 +187,7 @@ func resourcePowerSchedulePolicyCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePowerSchedulePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -261,7 +261,7 @@ func resourcePowerSchedulePolicyRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePowerSchedulePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -331,7 +331,7 @@ func resourcePowerSchedulePolicyUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePowerSchedulePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
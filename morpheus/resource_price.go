@@ -134,7 +134,7 @@ func resourcePrice() *schema.Resource {
 }
 
 func resourcePriceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -209,7 +209,7 @@ func resourcePriceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourcePriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -292,7 +292,7 @@ func resourcePriceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func resourcePriceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	price := make(map[string]interface{})
@@ -356,7 +356,7 @@ func resourcePriceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourcePriceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -139,7 +139,7 @@ func resourcePythonScriptTask() *schema.Resource {
 }
 
 func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -210,7 +210,7 @@ func resourcePythonScriptTaskCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -264,7 +264,7 @@ func resourcePythonScriptTaskRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -329,7 +329,7 @@ func resourcePythonScriptTaskUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePythonScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -74,7 +74,7 @@ func resourceRestartTask() *schema.Resource {
 }
 
 func resourceRestartTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -123,7 +123,7 @@ func resourceRestartTaskCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceRestartTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -171,7 +171,7 @@ func resourceRestartTaskRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceRestartTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	taskType := make(map[string]interface{})
@@ -215,7 +215,7 @@ func resourceRestartTaskUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceRestartTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
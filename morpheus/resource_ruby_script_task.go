@@ -115,7 +115,7 @@ func resourceRubyScriptTask() *schema.Resource {
 }
 
 func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -180,7 +180,7 @@ func resourceRubyScriptTaskCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -234,7 +234,7 @@ func resourceRubyScriptTaskRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 
@@ -293,7 +293,7 @@ func resourceRubyScriptTaskUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRubyScriptTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -77,7 +77,7 @@ func resourceScriptTemplate() *schema.Resource {
 }
 
 func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -121,7 +121,7 @@ func resourceScriptTemplateCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -169,7 +169,7 @@ func resourceScriptTemplateRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	name := d.Get("name").(string)
@@ -210,7 +210,7 @@ func resourceScriptTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScriptTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -0,0 +1,246 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus security group resource",
+		CreateContext: resourceSecurityGroupCreate,
+		ReadContext:   resourceSecurityGroupRead,
+		UpdateContext: resourceSecurityGroupUpdate,
+		DeleteContext: resourceSecurityGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the security group",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the security group",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the security group",
+				Optional:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the security group is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud to create the security group in, the security group is only managed by Morpheus when omitted",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"custom_options": {
+				Type:        schema.TypeMap,
+				Description: "The cloud specific options of the security group, such as the vpc of an Amazon cloud",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"locations": {
+				Type:        schema.TypeList,
+				Description: "The clouds the security group exists in",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the security group location",
+							Computed:    true,
+						},
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud of the security group location",
+							Computed:    true,
+						},
+						"cloud_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the cloud of the security group location",
+							Computed:    true,
+						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the security group in the cloud",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	securityGroup := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"active":      d.Get("active").(bool),
+	}
+	if cloudID, ok := d.GetOk("cloud_id"); ok {
+		securityGroup["zoneId"] = cloudID.(int)
+	}
+	if customOptions, ok := d.GetOk("custom_options"); ok {
+		securityGroup["customOptions"] = customOptions.(map[string]interface{})
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   "/api/security-groups",
+		Body: map[string]interface{}{
+			"securityGroup": securityGroup,
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var securityGroupPayload SecurityGroupPayload
+	if err := json.Unmarshal(resp.Body, &securityGroupPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(securityGroupPayload.SecurityGroup.ID))
+
+	resourceSecurityGroupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/security-groups/%s", id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var securityGroupPayload SecurityGroupPayload
+	if err := json.Unmarshal(resp.Body, &securityGroupPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	securityGroup := securityGroupPayload.SecurityGroup
+	d.SetId(int64ToString(securityGroup.ID))
+	d.Set("name", securityGroup.Name)
+	d.Set("description", securityGroup.Description)
+	d.Set("active", securityGroup.Active)
+	var locations []map[string]interface{}
+	for _, location := range securityGroup.Locations {
+		locations = append(locations, map[string]interface{}{
+			"id":          location.ID,
+			"cloud_id":    location.Zone.ID,
+			"cloud_name":  location.Zone.Name,
+			"external_id": location.ExternalId,
+		})
+	}
+	d.Set("locations", locations)
+	return diags
+}
+
+func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/security-groups/%s", id),
+		Body: map[string]interface{}{
+			"securityGroup": map[string]interface{}{
+				"name":        d.Get("name").(string),
+				"description": d.Get("description").(string),
+				"active":      d.Get("active").(bool),
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceSecurityGroupRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/security-groups/%s", id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+type SecurityGroupPayload struct {
+	SecurityGroup struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Active      bool   `json:"active"`
+		Locations   []struct {
+			ID   int64 `json:"id"`
+			Zone struct {
+				ID   int64  `json:"id"`
+				Name string `json:"name"`
+			} `json:"zone"`
+			ExternalId string `json:"externalId"`
+		} `json:"locations"`
+	} `json:"securityGroup"`
+}
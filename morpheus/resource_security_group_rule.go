@@ -0,0 +1,298 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// securityGroupRulePortRange matches a single port or a range of ports.
+var securityGroupRulePortRange = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+func resourceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus security group rule resource",
+		CreateContext: resourceSecurityGroupRuleCreate,
+		ReadContext:   resourceSecurityGroupRuleRead,
+		UpdateContext: resourceSecurityGroupRuleUpdate,
+		DeleteContext: resourceSecurityGroupRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the security group rule",
+				Computed:    true,
+			},
+			"security_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the security group the rule belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the security group rule",
+				Optional:    true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Description:  "The direction of the traffic the rule applies to (ingress, egress)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+				Default:      "ingress",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "The protocol of the traffic the rule applies to (tcp, udp, icmp)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp"}, false),
+				Default:      "tcp",
+			},
+			"port_range": {
+				Type:         schema.TypeString,
+				Description:  "The port or range of ports the rule applies to (e.g. 22 or 8000-8080)",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(securityGroupRulePortRange, "must be a port or a range of ports such as 22 or 8000-8080"),
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the source of the traffic (cidr, group, tier, instance, all). Tier is the instance type selected with source_instance_type_id and instance is the instance the security group is applied to",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cidr", "group", "tier", "instance", "all"}, false),
+				Default:      "cidr",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Description:  "The source CIDR of the traffic when source_type is cidr",
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"source_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the source security group of the traffic when source_type is group",
+				Optional:    true,
+			},
+			"source_instance_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the source instance type of the traffic when source_type is tier",
+				Optional:    true,
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the destination of the traffic (cidr, group, tier, instance, all)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cidr", "group", "tier", "instance", "all"}, false),
+				Default:      "instance",
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Description:  "The destination CIDR of the traffic when destination_type is cidr",
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"destination_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the destination security group of the traffic when destination_type is group",
+				Optional:    true,
+			},
+			"destination_instance_type_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the destination instance type of the traffic when destination_type is tier",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the security group rule is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithParentID("security_group_id"),
+		},
+	}
+}
+
+func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("/api/security-groups/%d/rules", d.Get("security_group_id").(int)),
+		Body: map[string]interface{}{
+			"rule": parseSecurityGroupRulePayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var rulePayload SecurityGroupRulePayload
+	if err := json.Unmarshal(resp.Body, &rulePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(rulePayload.Rule.ID))
+
+	resourceSecurityGroupRuleRead(ctx, d, meta)
+	return diags
+}
+
+func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("/api/security-groups/%d/rules/%s", d.Get("security_group_id").(int), id),
+	})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var rulePayload SecurityGroupRulePayload
+	if err := json.Unmarshal(resp.Body, &rulePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	rule := rulePayload.Rule
+	d.SetId(int64ToString(rule.ID))
+	d.Set("name", rule.Name)
+	d.Set("direction", rule.Direction)
+	d.Set("protocol", rule.Protocol)
+	d.Set("port_range", rule.PortRange)
+	d.Set("source_type", rule.SourceType)
+	d.Set("source", rule.Source)
+	d.Set("source_group_id", rule.SourceGroup.ID)
+	d.Set("source_instance_type_id", rule.SourceTier.ID)
+	d.Set("destination_type", rule.DestinationType)
+	d.Set("destination", rule.Destination)
+	d.Set("destination_group_id", rule.DestinationGroup.ID)
+	d.Set("destination_instance_type_id", rule.DestinationTier.ID)
+	d.Set("enabled", rule.Enabled)
+	return diags
+}
+
+func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/api/security-groups/%d/rules/%s", d.Get("security_group_id").(int), id),
+		Body: map[string]interface{}{
+			"rule": parseSecurityGroupRulePayload(d),
+		},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceSecurityGroupRuleRead(ctx, d, meta)
+}
+
+func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/security-groups/%d/rules/%s", d.Get("security_group_id").(int), id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseSecurityGroupRulePayload(d *schema.ResourceData) map[string]interface{} {
+	rule := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"ruleType":        "customRule",
+		"direction":       d.Get("direction").(string),
+		"protocol":        d.Get("protocol").(string),
+		"portRange":       d.Get("port_range").(string),
+		"sourceType":      d.Get("source_type").(string),
+		"source":          d.Get("source").(string),
+		"destinationType": d.Get("destination_type").(string),
+		"destination":     d.Get("destination").(string),
+		"enabled":         d.Get("enabled").(bool),
+	}
+	if groupID, ok := d.GetOk("source_group_id"); ok {
+		rule["sourceGroup"] = map[string]interface{}{"id": groupID.(int)}
+	}
+	if instanceTypeID, ok := d.GetOk("source_instance_type_id"); ok {
+		rule["sourceTier"] = map[string]interface{}{"id": instanceTypeID.(int)}
+	}
+	if groupID, ok := d.GetOk("destination_group_id"); ok {
+		rule["destinationGroup"] = map[string]interface{}{"id": groupID.(int)}
+	}
+	if instanceTypeID, ok := d.GetOk("destination_instance_type_id"); ok {
+		rule["destinationTier"] = map[string]interface{}{"id": instanceTypeID.(int)}
+	}
+	return rule
+}
+
+type SecurityGroupRulePayload struct {
+	Rule struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Direction   string `json:"direction"`
+		Protocol    string `json:"protocol"`
+		PortRange   string `json:"portRange"`
+		SourceType  string `json:"sourceType"`
+		Source      string `json:"source"`
+		SourceGroup struct {
+			ID int64 `json:"id"`
+		} `json:"sourceGroup"`
+		SourceTier struct {
+			ID int64 `json:"id"`
+		} `json:"sourceTier"`
+		DestinationType  string `json:"destinationType"`
+		Destination      string `json:"destination"`
+		DestinationGroup struct {
+			ID int64 `json:"id"`
+		} `json:"destinationGroup"`
+		DestinationTier struct {
+			ID int64 `json:"id"`
+		} `json:"destinationTier"`
+		Enabled bool `json:"enabled"`
+	} `json:"rule"`
+}
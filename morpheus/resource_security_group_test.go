@@ -0,0 +1,75 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMorpheusSecurityGroup_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_security_group.tfacc"
+	ruleName := "morpheus_security_group_rule.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			srv.checkDestroy("morpheus_security_group"),
+			srv.checkDestroy("morpheus_security_group_rule"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusSecurityGroupConfig("first", "22"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrPair(ruleName, "security_group_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(ruleName, "direction", "ingress"),
+					resource.TestCheckResourceAttr(ruleName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(ruleName, "port_range", "22"),
+					resource.TestCheckResourceAttr(ruleName, "source", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(ruleName, "destination_type", "instance"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusSecurityGroupConfig("second", "8000-8080"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(ruleName, "port_range", "8000-8080"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: ruleName,
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs := state.RootModule().Resources[ruleName]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["security_group_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusSecurityGroupConfig(description, portRange string) string {
+	return fmt.Sprintf(`
+resource "morpheus_security_group" "tfacc" {
+  name        = "tfacc"
+  description = %q
+}
+
+resource "morpheus_security_group_rule" "tfacc" {
+  security_group_id = morpheus_security_group.tfacc.id
+  name              = "tfacc"
+  port_range        = %q
+  source            = "10.0.0.0/8"
+}
+`, description, portRange)
+}
@@ -120,7 +120,7 @@ func resourceSelectListOptionType() *schema.Resource {
 }
 
 func resourceSelectListOptionTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -175,7 +175,7 @@ func resourceSelectListOptionTypeCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSelectListOptionTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -236,7 +236,7 @@ func resourceSelectListOptionTypeRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceSelectListOptionTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -286,7 +286,7 @@ func resourceSelectListOptionTypeUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSelectListOptionTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
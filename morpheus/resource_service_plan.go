@@ -188,7 +188,7 @@ func resourceServicePlan() *schema.Resource {
 }
 
 func resourceServicePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -321,7 +321,7 @@ func resourceServicePlanCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceServicePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -413,7 +413,7 @@ func resourceServicePlanRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceServicePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	servicePlan := make(map[string]interface{})
@@ -542,7 +542,7 @@ func resourceServicePlanUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceServicePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
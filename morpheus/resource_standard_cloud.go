@@ -19,6 +19,9 @@ func resourceStandardCloud() *schema.Resource {
 		ReadContext:   resourceStandardCloudRead,
 		UpdateContext: resourceStandardCloudUpdate,
 		DeleteContext: resourceStandardCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -148,7 +151,7 @@ func resourceStandardCloud() *schema.Resource {
 }
 
 func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -244,9 +247,10 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		PollInterval: 30 * time.Second,
+		Delay:        pollDelay(meta, 0),
+		PollInterval: pollInterval(meta, 30*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -298,7 +302,7 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -372,7 +376,7 @@ func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	// Name
@@ -493,7 +497,7 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceStandardCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
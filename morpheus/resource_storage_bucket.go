@@ -0,0 +1,416 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// storageBucketProviders maps the provider blocks of a storage bucket to the
+// config keys the API expects for each attribute of the block
+var storageBucketProviders = map[string]map[string]string{
+	"s3": {
+		"access_key": "accessKey",
+		"secret_key": "secretKey",
+		"endpoint":   "endpoint",
+		"region":     "region",
+	},
+	"azure": {
+		"storage_account": "storageAccount",
+		"storage_key":     "storageKey",
+	},
+	"nfs": {
+		"host":          "host",
+		"export_folder": "exportFolder",
+	},
+	"local": {
+		"base_path": "basePath",
+	},
+}
+
+func resourceStorageBucket() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus storage bucket resource, used as a target for backups, deployments, virtual images and archives",
+		CreateContext: resourceStorageBucketCreate,
+		ReadContext:   resourceStorageBucketRead,
+		UpdateContext: resourceStorageBucketUpdate,
+		DeleteContext: resourceStorageBucketDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the storage bucket",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the storage bucket",
+				Required:    true,
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the bucket or container at the storage provider, required for s3 and azure storage buckets",
+				Optional:    true,
+			},
+			"create_bucket": {
+				Type:        schema.TypeBool,
+				Description: "Whether to create the bucket at the storage provider if it does not exist yet",
+				Optional:    true,
+				Default:     false,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"default_backup_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for backups",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_deployment_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for deployment archives",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_virtual_image_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for virtual images",
+				Optional:    true,
+				Default:     false,
+			},
+			"retention_policy_type": {
+				Type:        schema.TypeString,
+				Description: "The retention policy of the files in the storage bucket (none, backup, delete)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"retention_policy_days": {
+				Type:        schema.TypeInt,
+				Description: "The number of days files are kept in the storage bucket before the retention policy is applied",
+				Optional:    true,
+				Computed:    true,
+			},
+			"s3": {
+				Type:         schema.TypeList,
+				Description:  "The settings of an Amazon S3 or S3 compatible storage bucket",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3", "azure", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:        schema.TypeString,
+							Description: "The access key used to authenticate with S3",
+							Required:    true,
+						},
+						"secret_key": {
+							Type:        schema.TypeString,
+							Description: "The secret key used to authenticate with S3",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Description: "The endpoint of an S3 compatible storage service, such as https://s3.us-east-1.amazonaws.com",
+							Optional:    true,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Description: "The region of the S3 bucket",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"azure": {
+				Type:         schema.TypeList,
+				Description:  "The settings of an Azure Blob storage container",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3", "azure", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_account": {
+							Type:        schema.TypeString,
+							Description: "The name of the Azure storage account",
+							Required:    true,
+						},
+						"storage_key": {
+							Type:        schema.TypeString,
+							Description: "The access key of the Azure storage account",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"nfs": {
+				Type:         schema.TypeList,
+				Description:  "The settings of an NFSv3 storage bucket",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3", "azure", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The hostname or IP address of the NFS server",
+							Required:    true,
+						},
+						"export_folder": {
+							Type:        schema.TypeString,
+							Description: "The exported folder on the NFS server",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"local": {
+				Type:         schema.TypeList,
+				Description:  "The settings of a storage bucket on the local disk of the Morpheus appliance",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3", "azure", "nfs", "local"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_path": {
+							Type:        schema.TypeString,
+							Description: "The path of the storage bucket on the Morpheus appliance",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: storageProviderCustomizeDiff(storageBucketProviders),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStorageBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	storageBucket := parseStorageBucketPayload(d)
+	storageBucket["createBucket"] = d.Get("create_bucket").(bool)
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageBucket": storageBucket,
+		},
+	}
+	resp, err := client.CreateStorageBucket(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	var storageBucketPayload StorageBucketPayload
+	if err := json.Unmarshal(resp.Body, &storageBucketPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(storageBucketPayload.StorageBucket.ID))
+
+	resourceStorageBucketRead(ctx, d, meta)
+	return diags
+}
+
+func resourceStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.GetStorageBucket(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		// 404 is ok?
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	var storageBucketPayload StorageBucketPayload
+	if err := json.Unmarshal(resp.Body, &storageBucketPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	storageBucket := storageBucketPayload.StorageBucket
+	d.SetId(int64ToString(storageBucket.ID))
+	d.Set("name", storageBucket.Name)
+	d.Set("bucket_name", storageBucket.BucketName)
+	d.Set("active", storageBucket.Active)
+	d.Set("default_backup_target", storageBucket.DefaultBackupTarget)
+	d.Set("default_deployment_target", storageBucket.DefaultDeploymentTarget)
+	d.Set("default_virtual_image_target", storageBucket.DefaultVirtualImageTarget)
+	d.Set("retention_policy_type", storageBucket.RetentionPolicyType)
+	d.Set("retention_policy_days", apiInt64(storageBucket.RetentionPolicyDays))
+	setStorageProviderConfig(d, storageBucketProviders, storageBucket.ProviderType, storageBucket.Config)
+	return diags
+}
+
+func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageBucket": parseStorageBucketPayload(d),
+		},
+	}
+	resp, err := client.UpdateStorageBucket(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	return resourceStorageBucketRead(ctx, d, meta)
+}
+
+func resourceStorageBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerMeta).client
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteStorageBucket(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func parseStorageBucketPayload(d *schema.ResourceData) map[string]interface{} {
+	providerType, config := parseStorageProviderConfig(d, storageBucketProviders)
+	storageBucket := map[string]interface{}{
+		"name":                      d.Get("name").(string),
+		"providerType":              providerType,
+		"bucketName":                d.Get("bucket_name").(string),
+		"active":                    d.Get("active").(bool),
+		"defaultBackupTarget":       d.Get("default_backup_target").(bool),
+		"defaultDeploymentTarget":   d.Get("default_deployment_target").(bool),
+		"defaultVirtualImageTarget": d.Get("default_virtual_image_target").(bool),
+		"config":                    config,
+	}
+	if retentionPolicyType, ok := d.GetOk("retention_policy_type"); ok {
+		storageBucket["retentionPolicyType"] = retentionPolicyType.(string)
+	}
+	if retentionPolicyDays, ok := d.GetOk("retention_policy_days"); ok {
+		storageBucket["retentionPolicyDays"] = retentionPolicyDays.(int)
+	}
+	return storageBucket
+}
+
+// parseStorageProviderConfig returns the provider type and API config of
+// whichever provider block is set
+func parseStorageProviderConfig(d *schema.ResourceData, providers map[string]map[string]string) (string, map[string]interface{}) {
+	for providerType, fields := range providers {
+		blocks := d.Get(providerType).([]interface{})
+		if len(blocks) == 0 || blocks[0] == nil {
+			continue
+		}
+		block := blocks[0].(map[string]interface{})
+		config := make(map[string]interface{})
+		for attribute, configKey := range fields {
+			config[configKey] = block[attribute]
+		}
+		return providerType, config
+	}
+	return "", map[string]interface{}{}
+}
+
+// setStorageProviderConfig stores the API config in the provider block that
+// matches the provider type. The API masks sensitive values, so those are
+// kept as they are in the state.
+func setStorageProviderConfig(d *schema.ResourceData, providers map[string]map[string]string, providerType string, config map[string]interface{}) {
+	for name, fields := range providers {
+		if name != providerType {
+			d.Set(name, nil)
+			continue
+		}
+		block := make(map[string]interface{})
+		for attribute, configKey := range fields {
+			key := fmt.Sprintf("%s.0.%s", name, attribute)
+			if storageProviderSecrets[attribute] {
+				block[attribute] = d.Get(key)
+			} else if value, ok := config[configKey].(string); ok {
+				block[attribute] = value
+			} else {
+				block[attribute] = ""
+			}
+		}
+		d.Set(name, []interface{}{block})
+	}
+}
+
+// storageProviderSecrets are the provider block attributes that are never
+// returned by the API
+var storageProviderSecrets = map[string]bool{
+	"secret_key":  true,
+	"storage_key": true,
+	"password":    true,
+}
+
+// storageProviderCustomizeDiff forces a new resource when the provider block
+// changes, the provider type of existing storage can not be changed
+func storageProviderCustomizeDiff(providers map[string]map[string]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		for providerType := range providers {
+			o, n := d.GetChange(providerType)
+			if len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+				return d.ForceNew(providerType)
+			}
+		}
+		return nil
+	}
+}
+
+type StorageBucketPayload struct {
+	StorageBucket struct {
+		ID                        int64                  `json:"id"`
+		Name                      string                 `json:"name"`
+		ProviderType              string                 `json:"providerType"`
+		BucketName                string                 `json:"bucketName"`
+		Active                    bool                   `json:"active"`
+		DefaultBackupTarget       bool                   `json:"defaultBackupTarget"`
+		DefaultDeploymentTarget   bool                   `json:"defaultDeploymentTarget"`
+		DefaultVirtualImageTarget bool                   `json:"defaultVirtualImageTarget"`
+		RetentionPolicyType       string                 `json:"retentionPolicyType"`
+		RetentionPolicyDays       interface{}            `json:"retentionPolicyDays"`
+		Config                    map[string]interface{} `json:"config"`
+	} `json:"storageBucket"`
+}
@@ -0,0 +1,81 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusStorageBucket_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_storage_bucket.tfacc"
+	fileShareName := "morpheus_file_share.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			srv.checkDestroy("morpheus_storage_bucket"),
+			srv.checkDestroy("morpheus_file_share"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusStorageBucketConfig("us-east-1", "/exports/backups"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", "tfacc-backups"),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.access_key", "AKIAEXAMPLE"),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.secret_key", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.region", "us-east-1"),
+					resource.TestCheckResourceAttr(resourceName, "default_backup_target", "true"),
+					resource.TestCheckResourceAttr(fileShareName, "nfs.0.host", "nfs.example.com"),
+					resource.TestCheckResourceAttr(fileShareName, "nfs.0.export_folder", "/exports/backups"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusStorageBucketConfig("us-west-2", "/exports/images"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "s3.0.region", "us-west-2"),
+					resource.TestCheckResourceAttr(resourceName, "s3.0.secret_key", "secret"),
+					resource.TestCheckResourceAttr(fileShareName, "nfs.0.export_folder", "/exports/images"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_bucket", "s3.0.secret_key"},
+			},
+			{
+				ResourceName:      fileShareName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusStorageBucketConfig(region, exportFolder string) string {
+	return fmt.Sprintf(`
+resource "morpheus_storage_bucket" "tfacc" {
+  name                  = "tfacc"
+  bucket_name           = "tfacc-backups"
+  default_backup_target = true
+
+  s3 {
+    access_key = "AKIAEXAMPLE"
+    secret_key = "secret"
+    region     = %q
+  }
+}
+
+resource "morpheus_file_share" "tfacc" {
+  name = "tfacc"
+
+  nfs {
+    host          = "nfs.example.com"
+    export_folder = %q
+  }
+}
+`, region, exportFolder)
+}
@@ -108,7 +108,7 @@ func resourceTagPolicy() *schema.Resource {
 }
 
 func resourceTagPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -175,7 +175,7 @@ func resourceTagPolicyCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -246,7 +246,7 @@ func resourceTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceTagPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -310,7 +310,7 @@ func resourceTagPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceTagPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
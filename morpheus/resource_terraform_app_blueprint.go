@@ -113,7 +113,7 @@ func resourceTerraformAppBlueprint() *schema.Resource {
 }
 
 func resourceTerraformAppBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -197,7 +197,7 @@ func resourceTerraformAppBlueprintCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -271,7 +271,7 @@ func resourceTerraformAppBlueprintRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	blueprint_type := "terraform"
@@ -349,7 +349,7 @@ func resourceTerraformAppBlueprintUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTerraformAppBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -54,10 +54,25 @@ func resourceMorpheusUser() *schema.Resource {
 				Required:    true,
 			},
 			"password": {
-				Description: "The Morpheus password for the user account (external password changes are not detected)",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:  "The Morpheus password for the user account (external password changes are not detected)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Description:  "The Morpheus password for the user account, which is not stored in state. Requires Terraform 1.11 or later",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				RequiredWith: []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Description: "The version of the write-only password, which must be changed to update the password",
+				Type:        schema.TypeInt,
+				Optional:    true,
 			},
 			"password_expired": {
 				Description: "Set user password expiration. After the first login you will be prompted to create a new password. This attribute only works during the initial user creation and will force the user to be deleted and recreated if the attribute is changed.",
@@ -113,7 +128,7 @@ func resourceMorpheusUser() *schema.Resource {
 }
 
 func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -147,7 +162,7 @@ func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, met
 				"lastName":             d.Get("last_name").(string),
 				"username":             d.Get("username").(string),
 				"email":                d.Get("email").(string),
-				"password":             d.Get("password").(string),
+				"password":             userPassword(d),
 				"passwordExpired":      d.Get("password_expired").(bool),
 				"receiveNotifications": d.Get("receive_notifications").(bool),
 				"linuxUsername":        d.Get("linux_username").(string),
@@ -176,7 +191,7 @@ func resourceMorpheusUserCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -233,7 +248,7 @@ func resourceMorpheusUserRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	// roles
@@ -248,23 +263,30 @@ func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	userPayload := map[string]interface{}{
+		"firstName":            d.Get("first_name").(string),
+		"lastName":             d.Get("last_name").(string),
+		"username":             d.Get("username").(string),
+		"email":                d.Get("email").(string),
+		"password":             userPassword(d),
+		"passwordExpired":      d.Get("password_expired").(bool),
+		"receiveNotifications": d.Get("receive_notifications").(bool),
+		"linuxUsername":        d.Get("linux_username").(string),
+		"linuxPassword":        d.Get("linux_password").(string),
+		"linuxKeyPairId":       d.Get("linux_keypair_id").(int),
+		"windowsUsername":      d.Get("windows_username").(string),
+		"windowsPassword":      d.Get("windows_password").(string),
+		"roles":                roles,
+	}
+	// The write-only password is only sent when its version changes, so
+	// that other changes do not reset the password of the user
+	if _, ok := d.GetOk("password_wo_version"); ok && !d.HasChange("password_wo_version") {
+		delete(userPayload, "password")
+	}
+
 	req := &morpheus.Request{
 		Body: map[string]interface{}{
-			"user": map[string]interface{}{
-				"firstName":            d.Get("first_name").(string),
-				"lastName":             d.Get("last_name").(string),
-				"username":             d.Get("username").(string),
-				"email":                d.Get("email").(string),
-				"password":             d.Get("password").(string),
-				"passwordExpired":      d.Get("password_expired").(bool),
-				"receiveNotifications": d.Get("receive_notifications").(bool),
-				"linuxUsername":        d.Get("linux_username").(string),
-				"linuxPassword":        d.Get("linux_password").(string),
-				"linuxKeyPairId":       d.Get("linux_keypair_id").(int),
-				"windowsUsername":      d.Get("windows_username").(string),
-				"windowsPassword":      d.Get("windows_password").(string),
-				"roles":                roles,
-			},
+			"user": userPayload,
 		},
 	}
 
@@ -283,7 +305,7 @@ func resourceMorpheusUserUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMorpheusUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -330,3 +352,12 @@ func matchUserRoleIdsWithSchema(roleIds []int, declaredRoleIds []interface{}) []
 	}
 	return result
 }
+
+// userPassword returns the password of the user account from either the
+// password or the write-only password_wo argument.
+func userPassword(d *schema.ResourceData) string {
+	if password, ok := d.GetOk("password"); ok {
+		return password.(string)
+	}
+	return writeOnlyString(d, "password_wo")
+}
@@ -108,7 +108,7 @@ func resourceUserCreationPolicy() *schema.Resource {
 }
 
 func resourceUserCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -185,7 +185,7 @@ func resourceUserCreationPolicyCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -257,7 +257,7 @@ func resourceUserCreationPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceUserCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -330,7 +330,7 @@ func resourceUserCreationPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceUserCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
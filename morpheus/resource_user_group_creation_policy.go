@@ -103,7 +103,7 @@ func resourceUserGroupCreationPolicy() *schema.Resource {
 }
 
 func resourceUserGroupCreationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -174,7 +174,7 @@ func resourceUserGroupCreationPolicyCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -245,7 +245,7 @@ func resourceUserGroupCreationPolicyRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceUserGroupCreationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	policy := make(map[string]interface{})
@@ -313,7 +313,7 @@ func resourceUserGroupCreationPolicyUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceUserGroupCreationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
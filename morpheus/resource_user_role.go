@@ -64,7 +64,7 @@ func resourceUserRole() *schema.Resource {
 }
 
 func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -125,7 +125,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -384,7 +384,7 @@ func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	data := PermissionSet{}
@@ -438,7 +438,7 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
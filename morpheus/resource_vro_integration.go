@@ -87,7 +87,7 @@ func resourceVrealizeOrchestratorIntegration() *schema.Resource {
 }
 
 func resourceVrealizeOrchestratorIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -127,7 +127,7 @@ func resourceVrealizeOrchestratorIntegrationCreate(ctx context.Context, d *schem
 }
 
 func resourceVrealizeOrchestratorIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -173,7 +173,7 @@ func resourceVrealizeOrchestratorIntegrationRead(ctx context.Context, d *schema.
 }
 
 func resourceVrealizeOrchestratorIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	integration := make(map[string]interface{})
@@ -209,7 +209,7 @@ func resourceVrealizeOrchestratorIntegrationUpdate(ctx context.Context, d *schem
 }
 
 func resourceVrealizeOrchestratorIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
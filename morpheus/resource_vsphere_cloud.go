@@ -23,6 +23,9 @@ func resourceVsphereCloud() *schema.Resource {
 		ReadContext:   resourceVsphereCloudRead,
 		UpdateContext: resourceVsphereCloudUpdate,
 		DeleteContext: resourceVsphereCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -228,7 +231,7 @@ func resourceVsphereCloud() *schema.Resource {
 }
 
 func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -384,9 +387,10 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 0),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
@@ -402,7 +406,7 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -515,7 +519,7 @@ func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	cloud := make(map[string]interface{})
 	// Name
@@ -667,7 +671,7 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceVsphereCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
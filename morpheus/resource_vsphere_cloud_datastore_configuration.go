@@ -93,7 +93,7 @@ func resourceVSphereCloudDatastoreConfiguration() *schema.Resource {
 }
 
 func resourceVSphereCloudDatastoreConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -192,7 +192,7 @@ func resourceVSphereCloudDatastoreConfigurationCreate(ctx context.Context, d *sc
 }
 
 func resourceVSphereCloudDatastoreConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -235,7 +235,7 @@ func resourceVSphereCloudDatastoreConfigurationRead(ctx context.Context, d *sche
 }
 
 func resourceVSphereCloudDatastoreConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	id := d.Id()
 	cloudId := d.Get("cloud_id").(int)
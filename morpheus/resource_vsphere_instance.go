@@ -24,7 +24,7 @@ func resourceVsphereInstance() *schema.Resource {
 		UpdateContext: resourceVsphereInstanceUpdate,
 		DeleteContext: resourceVsphereInstanceDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(3 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -76,9 +76,8 @@ func resourceVsphereInstance() *schema.Resource {
 				Required:    true,
 			},
 			"plan_id": {
-				Description: "The service plan associated with the instance",
+				Description: "The service plan associated with the instance, changing the plan resizes the instance",
 				Type:        schema.TypeInt,
-				ForceNew:    true,
 				Required:    true,
 			},
 			"resource_pool_id": {
@@ -207,7 +206,7 @@ func resourceVsphereInstance() *schema.Resource {
 				},
 			},
 			"volumes": {
-				Description: "The instance volumes to create",
+				Description: "The instance volumes to create, volumes can be added, grown or removed by resizing the instance. Existing volumes are matched on the root flag and name, so volume names must be unique and cannot be changed. Removing every volume leaves the volumes of the instance unchanged",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -221,6 +220,7 @@ func resourceVsphereInstance() *schema.Resource {
 							Description: "The name/type of the LV being created",
 							Type:        schema.TypeString,
 							Optional:    true,
+							Computed:    true,
 						},
 						"size": {
 							Description: "The size of the LV being created",
@@ -256,7 +256,7 @@ func resourceVsphereInstance() *schema.Resource {
 				},
 			},
 			"interfaces": {
-				Description: "The instance network interfaces to create",
+				Description: "The instance network interfaces to create, network interfaces can be added or removed by resizing the instance. Existing network interfaces are matched on their network. Removing every interface leaves the network interfaces of the instance unchanged",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -318,9 +318,31 @@ func resourceVsphereInstance() *schema.Resource {
 					},
 				},
 			},
+			"power_state": powerStateSchema(),
+			"force_delete": {
+				Description: "Whether to force the deletion of the instance, even if the removal of its resources fails. Defaults to the force_delete argument of the provider",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Description: "Whether to preserve the volumes of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keep_backups": {
+				Description: "Whether to keep the backups of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"release_ips": {
+				Description: "Whether to release the elastic and floating IP addresses of the instance when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 		},
 		CustomizeDiff: customdiff.All(
 			volumesCustomizeDiff,
+			volumeRenamesCustomizeDiff,
 			customdiff.ForceNewIfChange("instance_type_code", func(ctx context.Context, old, new, meta interface{}) bool {
 				// We will force a new instance if instance_type_code has a non-zero value, which means that it has been
 				// set by the user
@@ -358,7 +380,7 @@ func volumesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta inte
 }
 
 func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -554,26 +576,36 @@ func resourceVsphereInstanceCreate(ctx context.Context, d *schema.ResourceData,
 			instance := result.Instance
 			return result, instance.Status, nil
 		},
-		Timeout:      3 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        3 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 3*time.Minute),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	created, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return diag.Errorf("error creating instance: %s", err)
 	}
 
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(instance.ID))
+
+	// Stop or suspend the instance once it is provisioned. The id is set
+	// first so the instance is kept in state if this fails
+	if powerState, ok := d.GetOk("power_state"); ok {
+		instanceStatus := created.(*morpheus.GetInstanceResult).Instance.Status
+		if err := setInstancePowerState(ctx, meta, instance.ID, instanceStatus, powerState.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error setting power state of instance: %s", err)
+		}
+	}
+
 	resourceVsphereInstanceRead(ctx, d, meta)
 	return diags
 }
 
 func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -633,6 +665,7 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("resource_pool_id", instance.Config["resourcePoolId"])
 	d.Set("environment", instance.Environment)
 	d.Set("labels", instance.Labels)
+	setPowerStateFromStatus(d, instance.Status)
 	d.Set("evar", instance.EnvironmentVariables)
 	// Tags
 	tags := make(map[string]interface{})
@@ -663,6 +696,40 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("custom_options", instance.Config["customOptions"])
 	d.Set("domain_id", instance.NetworkDomain.Id)
 
+	// The volumes are only read back when they are configured, in the
+	// order of the configuration
+	if stateVolumes := d.Get("volumes").([]interface{}); len(stateVolumes) > 0 {
+		var volumes []map[string]interface{}
+		// iterate over the array of volumes
+		for i := 0; i < len(instance.Volumes); i++ {
+			row := make(map[string]interface{})
+			volume := instance.Volumes[i]
+			row["root"] = volume.RootVolume == true
+			row["name"] = volume.Name
+			row["size"] = apiInt64(volume.Size)
+			row["storage_type"] = apiInt64(volume.StorageType)
+			row["datastore_id"] = apiInt64(volume.DatastoreId)
+			volumes = append(volumes, row)
+		}
+		volumes = orderVolumes(volumes, stateVolumes)
+		// The API does not return the size_id and datastore_auto_selection
+		// the volumes were created with, keep the configured values
+		for _, row := range volumes {
+			for _, v := range stateVolumes {
+				stateVolume := v.(map[string]interface{})
+				if !sameVolume(row, stateVolume) {
+					continue
+				}
+				row["size_id"] = stateVolume["size_id"]
+				if stateVolume["datastore_auto_selection"] != "" {
+					row["datastore_auto_selection"] = stateVolume["datastore_auto_selection"]
+					row["datastore_id"] = 0
+				}
+			}
+		}
+		d.Set("volumes", volumes)
+	}
+
 	var networkInterfaces []map[string]interface{}
 	// iterate over the array of interfaces
 	for i := 0; i < len(instance.Interfaces); i++ {
@@ -675,7 +742,11 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		row["network_interface_type_id"] = networkInterface.NetworkInterfaceTypeId
 		networkInterfaces = append(networkInterfaces, row)
 	}
-	d.Set("interfaces", networkInterfaces)
+	// The interfaces are only read back when they are configured, in the
+	// order of the configuration
+	if stateInterfaces := d.Get("interfaces").([]interface{}); len(stateInterfaces) > 0 {
+		d.Set("interfaces", orderNetworkInterfaces(networkInterfaces, stateInterfaces))
+	}
 
 	var connectionInfo []map[string]interface{}
 	// Iterate over the array of connection info
@@ -693,7 +764,7 @@ func resourceVsphereInstanceRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -741,13 +812,54 @@ func resourceVsphereInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.UpdateInstanceResult)
 	instance := result.Instance
+
+	// Resize the instance when the plan, volumes or interfaces have changed
+	if d.HasChanges("plan_id", "volumes", "interfaces") {
+		instanceGetResp, err := client.GetInstance(toInt64(id), &morpheus.Request{})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", instanceGetResp, err)
+			return diag.FromErr(err)
+		}
+		fetchInstance := instanceGetResp.Result.(*morpheus.GetInstanceResult).Instance
+
+		resizePayload := map[string]interface{}{
+			"instance": map[string]interface{}{
+				"plan": map[string]interface{}{
+					"id": d.Get("plan_id"),
+				},
+			},
+		}
+		// The volumes and interfaces of the instance are left unchanged
+		// when none are configured
+		if volumes := d.Get("volumes").([]interface{}); len(volumes) > 0 {
+			storageVolumes := parseStorageVolumes(volumes)
+			resizeVolumes(storageVolumes, fetchInstance.Volumes)
+			resizePayload["volumes"] = storageVolumes
+			resizePayload["deleteOriginalVolumes"] = true
+		}
+		if interfaces := d.Get("interfaces").([]interface{}); len(interfaces) > 0 {
+			networkInterfaces := parseNetworkInterfaces(interfaces)
+			resizeNetworkInterfaces(networkInterfaces, fetchInstance.Interfaces)
+			resizePayload["networkInterfaces"] = networkInterfaces
+		}
+		if err := resizeInstance(ctx, d, meta, toInt64(id), resizePayload); err != nil {
+			return diag.Errorf("error resizing instance: %s", err)
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := setInstancePowerState(ctx, meta, toInt64(id), "", d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating power state of instance: %s", err)
+		}
+	}
+
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(instance.ID))
 	return resourceVsphereInstanceRead(ctx, d, meta)
 }
 
 func resourceVsphereInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -756,9 +868,7 @@ func resourceVsphereInstanceDelete(ctx context.Context, d *schema.ResourceData,
 	req := &morpheus.Request{
 		QueryParams: map[string]string{},
 	}
-	if USE_FORCE {
-		req.QueryParams["force"] = "true"
-	}
+	deleteQueryParams(d, meta, req.QueryParams)
 	resp, err := client.DeleteInstance(toInt64(id), req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -775,9 +885,9 @@ func resourceVsphereInstanceDelete(ctx context.Context, d *schema.ResourceData,
 	// "name must be unique" we will GET the VM instance until such time as the instance
 	// isn't available
 	stateConf := retry.StateChangeConf{
-		Delay:        1 * time.Second,
-		Timeout:      5 * time.Minute,
-		PollInterval: 1 * time.Second,
+		Delay:        pollDelay(meta, 1*time.Second),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: pollInterval(meta, 1*time.Second),
 		MinTimeout:   1 * time.Second,
 		Pending:      []string{"200"},
 		Target:       []string{"404"},
@@ -61,7 +61,7 @@ func resourceVsphereMKSCluster() *schema.Resource {
 		UpdateContext: resourceVsphereMKSClusterUpdate,
 		DeleteContext: resourceVsphereMKSClusterDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(45 * time.Minute),
+			Create: schema.DefaultTimeout(3 * time.Hour),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(45 * time.Minute),
 			Delete: schema.DefaultTimeout(45 * time.Minute),
@@ -383,6 +383,26 @@ func resourceVsphereMKSCluster() *schema.Resource {
 					},
 				},
 			},
+			"force_delete": {
+				Description: "Whether to force the deletion of the cluster, even if the removal of its resources fails. Defaults to the force_delete argument of the provider",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"preserve_volumes": {
+				Description: "Whether to preserve the volumes of the cluster when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"keep_backups": {
+				Description: "Whether to keep the backups of the cluster when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"release_ips": {
+				Description: "Whether to release the elastic and floating IP addresses of the cluster when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -435,7 +455,7 @@ func filterOutClusterWorkersByStatus(workers []morpheus.ClusterWorker, status st
 }
 
 func resourceVsphereMKSClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -580,10 +600,10 @@ func resourceVsphereMKSClusterCreate(ctx context.Context, d *schema.ResourceData
 
 			return result, clusterStatus, nil
 		},
-		Timeout:      3 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        3 * time.Minute,
-		PollInterval: 1 * time.Minute,
+		Delay:        pollDelay(meta, 3*time.Minute),
+		PollInterval: pollInterval(meta, 1*time.Minute),
 	}
 
 	// Wait, catching any errors
@@ -604,7 +624,7 @@ func resourceVsphereMKSClusterCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceVsphereMKSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -776,10 +796,10 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 
 			return "", statusProvisioning, nil
 		},
-		Timeout:      30 * time.Minute,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: pollIntervalSeconds * time.Second,
+		Delay:        pollDelay(client, 1*time.Minute),
+		PollInterval: pollInterval(client, pollIntervalSeconds*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -791,7 +811,7 @@ func doClusterWorkerAdd(ctx context.Context, client *morpheus.Client, clusterId
 	return nil
 }
 
-func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, clusterId int64, nodeCount int) error {
+func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, clusterId int64, nodeCount int, d *schema.ResourceData) error {
 	workers, err := getClusterWorkers(client, clusterId)
 	if err != nil {
 		return err
@@ -826,10 +846,10 @@ func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, cluster
 
 			return "", statusDeprovisioning, nil
 		},
-		Timeout:      30 * time.Minute,
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: pollIntervalSeconds * time.Second,
+		Delay:        pollDelay(client, 1*time.Minute),
+		PollInterval: pollInterval(client, pollIntervalSeconds*time.Second),
 	}
 
 	// Wait, catching any errors
@@ -842,7 +862,7 @@ func doClusterWorkerDelete(ctx context.Context, client *morpheus.Client, cluster
 }
 
 func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	clusterId := toInt64(d.Id())
 
 	// First check for changes in worker node pool
@@ -877,7 +897,7 @@ func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData
 					return diag.Errorf("error adding cluster worker node(s): %s", err)
 				}
 			} else {
-				err := doClusterWorkerDelete(ctx, client, clusterId, countDelta)
+				err := doClusterWorkerDelete(ctx, client, clusterId, countDelta, d)
 				if err != nil {
 					return diag.Errorf("error deleting cluster worker node(s): %s", err)
 				}
@@ -912,7 +932,7 @@ func resourceVsphereMKSClusterUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceVsphereMKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -924,9 +944,7 @@ func resourceVsphereMKSClusterDelete(ctx context.Context, d *schema.ResourceData
 			"removeResources": "on",
 		},
 	}
-	if USE_FORCE {
-		req.QueryParams["force"] = "true"
-	}
+	deleteQueryParams(d, meta, req.QueryParams)
 	resp, err := client.DeleteCluster(toInt64(id), req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -954,10 +972,10 @@ func resourceVsphereMKSClusterDelete(ctx context.Context, d *schema.ResourceData
 			cluster := result.Cluster
 			return result, cluster.Status, nil
 		},
-		Timeout:      30 * time.Minute,
+		Timeout:      d.Timeout(schema.TimeoutDelete),
 		MinTimeout:   1 * time.Minute,
-		Delay:        1 * time.Minute,
-		PollInterval: 30 * time.Second,
+		Delay:        pollDelay(meta, 1*time.Minute),
+		PollInterval: pollInterval(meta, 30*time.Second),
 	}
 
 	// Wait, catching any errors
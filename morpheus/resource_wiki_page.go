@@ -53,7 +53,7 @@ func resourceWikiPage() *schema.Resource {
 }
 
 func resourceWikiPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -86,7 +86,7 @@ func resourceWikiPageCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceWikiPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -129,7 +129,7 @@ func resourceWikiPageRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceWikiPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	wikiPage := make(map[string]interface{})
@@ -159,7 +159,7 @@ func resourceWikiPageUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceWikiPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
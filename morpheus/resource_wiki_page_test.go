@@ -0,0 +1,49 @@
+package morpheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMorpheusWikiPage_basic(t *testing.T) {
+	srv := newFakeMorpheusServer(t)
+	resourceName := "morpheus_wiki_page.tfacc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      srv.checkDestroy("morpheus_wiki_page"),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + testAccMorpheusWikiPageConfig("tfacc", "# first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc"),
+					resource.TestCheckResourceAttr(resourceName, "content", "# first"),
+				),
+			},
+			{
+				Config: srv.providerConfig() + testAccMorpheusWikiPageConfig("tfacc", "# second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", "# second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMorpheusWikiPageConfig(name, content string) string {
+	return fmt.Sprintf(`
+resource "morpheus_wiki_page" "tfacc" {
+  name     = %q
+  category = "tfacc"
+  content  = %q
+}
+`, name, content)
+}
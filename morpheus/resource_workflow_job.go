@@ -112,7 +112,7 @@ func resourceWorkflowJob() *schema.Resource {
 }
 
 func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -209,7 +209,7 @@ func resourceWorkflowJobCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceWorkflowJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
@@ -302,7 +302,7 @@ func resourceWorkflowJobRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 
 	job := make(map[string]interface{})
@@ -395,7 +395,7 @@ func resourceWorkflowJobUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceWorkflowJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -88,7 +88,7 @@ func resourceWriteAttributesTask() *schema.Resource {
 }
 
 func resourceWriteAttributesTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -140,7 +140,7 @@ func resourceWriteAttributesTaskCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceWriteAttributesTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -190,7 +190,7 @@ func resourceWriteAttributesTaskRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceWriteAttributesTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 	id := d.Id()
 	name := d.Get("name").(string)
 	taskOptions := make(map[string]interface{})
@@ -238,7 +238,7 @@ func resourceWriteAttributesTaskUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceWriteAttributesTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*morpheus.Client)
+	client := meta.(*providerMeta).client
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
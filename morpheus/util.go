@@ -1,8 +1,17 @@
 package morpheus
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func jsonBytesEqual(b1, b2 []byte) bool {
@@ -42,3 +51,121 @@ func parseEnvironmentVariables(variables []interface{}) []map[string]interface{}
 	}
 	return evars
 }
+
+// pollInterval returns the poll interval configured on the provider,
+// or def if none was set.
+func pollInterval(meta interface{}, def time.Duration) time.Duration {
+	if interval := providerConfig(meta).PollInterval; interval > 0 {
+		return interval
+	}
+	return def
+}
+
+// pollDelay returns the delay before the first poll configured on the
+// provider, or def if none was set.
+func pollDelay(meta interface{}, def time.Duration) time.Duration {
+	if delay := providerConfig(meta).PollDelay; delay > 0 {
+		return delay
+	}
+	return def
+}
+
+// dataSourcePageSize is the number of objects requested per page by the
+// plural data sources.
+const dataSourcePageSize = 100
+
+// maxResultsSchema returns the max_results argument shared by the plural
+// data sources.
+func maxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "The maximum number of results to return. Defaults to 0 which returns every match",
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// listAllPages calls list with an increasing offset until Morpheus returns a
+// page shorter than dataSourcePageSize. list returns the number of objects
+// in the page and how many of them matched the filters of the data source,
+// so that paging stops once maxResults matches have been collected.
+func listAllPages(params map[string]string, maxResults int, list func(params map[string]string) (int, int, error)) error {
+	matches := 0
+	for offset := 0; ; offset += dataSourcePageSize {
+		params["max"] = strconv.Itoa(dataSourcePageSize)
+		params["offset"] = strconv.Itoa(offset)
+		size, matched, err := list(params)
+		if err != nil {
+			return err
+		}
+		matches += matched
+		if size < dataSourcePageSize || (maxResults > 0 && matches >= maxResults) {
+			return nil
+		}
+	}
+}
+
+// deleteQueryParams adds the query params of an instance, cluster or app delete
+// request set by the force_delete, preserve_volumes, keep_backups and
+// release_ips arguments of the resource to params. The force_delete argument
+// of the provider applies when the resource does not set its own.
+func deleteQueryParams(d *schema.ResourceData, meta interface{}, params map[string]string) {
+	force := providerConfig(meta).ForceDelete
+	// GetOk cannot tell an explicit false from an unset argument
+	if v, ok := d.GetOkExists("force_delete"); ok {
+		force = v.(bool)
+	}
+	if force {
+		params["force"] = "true"
+	}
+	if d.Get("preserve_volumes").(bool) {
+		params["preserveVolumes"] = "on"
+	}
+	if d.Get("keep_backups").(bool) {
+		params["keepBackups"] = "on"
+	}
+	if d.Get("release_ips").(bool) {
+		params["releaseEIPs"] = "on"
+		params["releaseFloatingIps"] = "on"
+	}
+}
+
+// apiIDs returns the IDs of a list of related objects of an API payload,
+// which the API returns either as IDs or as objects with an id attribute.
+func apiIDs(values []interface{}) []int64 {
+	var ids []int64
+	for _, value := range values {
+		if object, ok := value.(map[string]interface{}); ok {
+			ids = append(ids, apiInt64(object["id"]))
+		} else {
+			ids = append(ids, apiInt64(value))
+		}
+	}
+	return ids
+}
+
+// importStateWithParentID returns an importer for resources nested under a
+// parent object, such as security group rules or load balancer pools. The
+// import ID has the form <parent_id>:<id> and the parent ID is stored in
+// parentAttribute.
+func importStateWithParentID(parentAttribute string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), ":")
+		if len(parts) != 2 || toInt64(parts[0]) == 0 || toInt64(parts[1]) == 0 {
+			return nil, fmt.Errorf("invalid import ID %q, expected <%s>:<id>", d.Id(), parentAttribute)
+		}
+		d.Set(parentAttribute, int(toInt64(parts[0])))
+		d.SetId(parts[1])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// writeOnlyString returns the value of the write-only string attribute key,
+// which is only available in the configuration and never stored in state.
+func writeOnlyString(d *schema.ResourceData, key string) string {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
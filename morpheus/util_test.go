@@ -0,0 +1,76 @@
+package morpheus
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeleteQueryParams(t *testing.T) {
+	meta, diags := (&Config{Url: "https://morpheus.test", ForceDelete: true}).meta()
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating client: %v", diags)
+	}
+
+	cases := []struct {
+		name   string
+		raw    map[string]interface{}
+		meta   interface{}
+		expect map[string]string
+	}{
+		{
+			name:   "defaults",
+			raw:    map[string]interface{}{},
+			meta:   nil,
+			expect: map[string]string{},
+		},
+		{
+			name: "every option",
+			raw: map[string]interface{}{
+				"force_delete":     true,
+				"preserve_volumes": true,
+				"keep_backups":     true,
+				"release_ips":      true,
+			},
+			meta: nil,
+			expect: map[string]string{
+				"force":              "true",
+				"preserveVolumes":    "on",
+				"keepBackups":        "on",
+				"releaseEIPs":        "on",
+				"releaseFloatingIps": "on",
+			},
+		},
+		{
+			name:   "provider default",
+			raw:    map[string]interface{}{},
+			meta:   meta,
+			expect: map[string]string{"force": "true"},
+		},
+		{
+			name:   "resource overrides provider",
+			raw:    map[string]interface{}{"force_delete": false},
+			meta:   meta,
+			expect: map[string]string{},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceMVMInstance().Schema, tc.raw)
+			params := map[string]string{}
+			deleteQueryParams(d, tc.meta, params)
+			if !reflect.DeepEqual(params, tc.expect) {
+				t.Fatalf("expected %v, got %v", tc.expect, params)
+			}
+		})
+	}
+}
+
+func TestApiIDs(t *testing.T) {
+	values := []interface{}{float64(1), "2", map[string]interface{}{"id": float64(3), "name": "tfacc"}}
+	if got := fmt.Sprint(apiIDs(values)); got != "[1 2 3]" {
+		t.Fatalf("expected [1 2 3], got %s", got)
+	}
+}